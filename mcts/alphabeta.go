@@ -0,0 +1,313 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*				ALPHA-BETA SEARCH				*/
+/*												*/
+/************************************************/
+
+// The "alphabeta" strategy is a deterministic search to compare with MCTS. The simultaneous moves
+// are played one after the other, paranoid: our move first, then the opponent replies knowing it.
+// A depth is one move of each player, the leaves get the value of Evaluate. The depth grows by one
+// until the budget is spent and the move of the last finished depth is played.
+// The moves are tried in this order: the best move found at the last visit of the position, kept
+// in the transposition table under the hash of the state, then the killer moves of the
+// depth, which ended the search of another position of that depth, then COMPLETE, GROW and SEED
+// moves as the greedy plans rank them and WAIT last.
+
+const (
+	ALPHA_BETA_MAX_DEPTH = 30
+
+	// the budget is checked every this many nodes, leaves included
+	ALPHA_BETA_CHECK_PERIOD = 16
+)
+
+const (
+	EXACT = iota
+	LOWER_BOUND
+	UPPER_BOUND
+)
+
+type TTEntry struct {
+	depth    int
+	value    float64
+	bound    int
+	bestMove Move
+}
+
+// zobristKeys[cell][size][owner][dormant] are the random keys whose xor hashes the trees of a state,
+// symmetric states get different hashes but they only come up on the last day
+var zobristKeys [MAX_CELLS][4][2][2]uint64
+
+func init() {
+	rng := rand.New(rand.NewSource(1))
+	for cell := range zobristKeys {
+		for size := range zobristKeys[cell] {
+			for owner := range zobristKeys[cell][size] {
+				for dormant := range zobristKeys[cell][size][owner] {
+					zobristKeys[cell][size][owner][dormant] = rng.Uint64()
+				}
+			}
+		}
+	}
+}
+
+// GetHash returns the key of the state in the transposition table
+func (s State) GetHash() uint64 {
+	h := uint64(0)
+	for owner := 0; owner < 2; owner++ {
+		for _, cell := range s.activeTreesIndex[owner] {
+			h ^= zobristKeys[cell][s.treeMap[cell]][owner][0]
+		}
+		for _, cell := range s.dormantTreesIndex[owner] {
+			h ^= zobristKeys[cell][s.treeMap[cell]][owner][1]
+		}
+	}
+	for _, v := range []int{s.day, s.nutrients, s.sun[0], s.sun[1], s.score[0], s.score[1], s.isWaiting[0], s.isWaiting[1]} {
+		h = (h ^ uint64(v)) * 1099511628211
+	}
+	return h
+}
+
+type AlphaBeta struct {
+	table map[uint64]TTEntry
+
+	// killers[ply][playerCode] are the last two moves of playerCode that ended a search at ply
+	killers [ALPHA_BETA_MAX_DEPTH][2][2]Move
+
+	// lastDay is the day of the last search, the table is cleared on a new day
+	lastDay int
+
+	t0      time.Time
+	budget  Budget
+	nbNodes int
+	stopped bool
+
+	// interrupted ends the search early, bestMove is the move of the last finished depth,
+	// both can be used while the search runs in another goroutine
+	interrupted atomic.Bool
+	bestMove    atomic.Pointer[Move]
+}
+
+// SearchResult is what the last finished depth of a search found
+type SearchResult struct {
+	depth   int
+	value   float64
+	nbNodes int
+
+	// pv is the principal variation, our move and the reply of the opponent at each depth,
+	// it stops early when a position was cut by the transposition table
+	pv []Move
+}
+
+func newAlphaBeta() *AlphaBeta {
+	return &AlphaBeta{table: map[uint64]TTEntry{}}
+}
+
+// IsStopped returns true once the budget is spent or Stop was called, then the search unwinds
+// without storing anything
+func (ab *AlphaBeta) IsStopped() bool {
+	ab.nbNodes++
+	if !ab.stopped && ab.nbNodes%ALPHA_BETA_CHECK_PERIOD == 0 {
+		ab.stopped = ab.interrupted.Load() || ab.budget.IsSpent(ab.t0, ab.nbNodes)
+	}
+	return ab.stopped
+}
+
+// AddKiller makes m the first killer move of playerCode at ply
+func (ab *AlphaBeta) AddKiller(ply int, playerCode int, m Move) {
+	killers := &ab.killers[ply][playerCode]
+	if killers[0] != m {
+		killers[1], killers[0] = killers[0], m
+	}
+}
+
+// OrderMoves returns the search moves of playerCode in s in the order they are tried,
+// first is the best move from the transposition table, if any
+func (ab *AlphaBeta) OrderMoves(s State, playerCode int, ply int, first *Move) []Move {
+	moves := s.GetSearchMoves(playerCode)
+	killers := ab.killers[ply][playerCode]
+	codeOrder := [4]float64{COMPLETE: 3, GROW: 2, SEED: 1, WAIT: 0}
+	keys := make(map[Move]float64, len(moves))
+	for _, m := range moves {
+		switch {
+		case first != nil && m == *first:
+			keys[m] = 3000
+		case m == killers[0] && m.code != WAIT:
+			keys[m] = 2001
+		case m == killers[1] && m.code != WAIT:
+			keys[m] = 2000
+		default:
+			keys[m] = 100*codeOrder[m.code] + s.GetMoveRank(m, playerCode)
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool { return keys[moves[i]] > keys[moves[j]] })
+	return moves
+}
+
+// Max returns the value of s with depth moves of each player left and the principal variation
+func (ab *AlphaBeta) Max(s State, depth int, ply int, alpha float64, beta float64) (float64, []Move) {
+	if ab.IsStopped() {
+		return 0, nil
+	}
+	if depth == 0 || s.day >= rules.NbDays {
+		return Evaluate(s), nil
+	}
+
+	key := s.GetHash()
+	var ttMove *Move
+	if entry, ok := ab.table[key]; ok {
+		ttMove = &entry.bestMove
+		// the root is always searched, for its principal variation
+		if entry.depth >= depth && ply > 0 {
+			switch {
+			case entry.bound == EXACT:
+				return entry.value, nil
+			case entry.bound == LOWER_BOUND && entry.value >= beta:
+				return entry.value, nil
+			case entry.bound == UPPER_BOUND && entry.value <= alpha:
+				return entry.value, nil
+			}
+		}
+	}
+
+	alphaOrig := alpha
+	bestValue, bestMove := math.Inf(-1), Move{code: WAIT}
+	var pv []Move
+	for _, m := range ab.OrderMoves(s, PLAYER, ply, ttMove) {
+		value, replyPV := ab.Min(s, m, depth, ply, alpha, beta)
+		if ab.stopped {
+			return 0, nil
+		}
+		if value > bestValue {
+			bestValue, bestMove = value, m
+			pv = append([]Move{m}, replyPV...)
+		}
+		alpha = math.Max(alpha, value)
+		if alpha >= beta {
+			ab.AddKiller(ply, PLAYER, m)
+			break
+		}
+	}
+
+	entry := TTEntry{depth: depth, value: bestValue, bound: EXACT, bestMove: bestMove}
+	if bestValue <= alphaOrig {
+		entry.bound = UPPER_BOUND
+	} else if bestValue >= beta {
+		entry.bound = LOWER_BOUND
+	}
+	ab.table[key] = entry
+	return bestValue, pv
+}
+
+// Min returns the value of our move m in s, with the best reply of the opponent
+func (ab *AlphaBeta) Min(s State, m Move, depth int, ply int, alpha float64, beta float64) (float64, []Move) {
+	bestValue := math.Inf(1)
+	var pv []Move
+	for _, reply := range ab.OrderMoves(s, OPPONENT, ply, nil) {
+		value, childPV := ab.Max(s.Play(m, reply), depth-1, ply+1, alpha, beta)
+		if ab.stopped {
+			return 0, nil
+		}
+		if value < bestValue {
+			bestValue = value
+			pv = append([]Move{reply}, childPV...)
+		}
+		beta = math.Min(beta, value)
+		if alpha >= beta {
+			ab.AddKiller(ply, OPPONENT, reply)
+			break
+		}
+	}
+	return bestValue, pv
+}
+
+// Search deepens the search of s until the budget is spent and returns the result of the last
+// finished depth. When not even the first depth finished, the pv is the first move of the order.
+func (ab *AlphaBeta) Search(s State, budget Budget) SearchResult {
+	if ab.lastDay != s.day {
+		// the states of the previous days never come back
+		ab.table = map[uint64]TTEntry{}
+		ab.lastDay = s.day
+	}
+	ab.t0, ab.budget, ab.nbNodes, ab.stopped = time.Now(), budget, 0, false
+	ab.killers = [ALPHA_BETA_MAX_DEPTH][2][2]Move{}
+
+	result := SearchResult{pv: ab.OrderMoves(s, PLAYER, 0, nil)[:1]}
+	ab.PublishBestMove(result.pv[0])
+	for depth := 1; depth < ALPHA_BETA_MAX_DEPTH; depth++ {
+		value, pv := ab.Max(s, depth, 0, math.Inf(-1), math.Inf(1))
+		if ab.stopped {
+			break
+		}
+		result = SearchResult{depth: depth, value: value, nbNodes: ab.nbNodes, pv: pv}
+		ab.PublishBestMove(pv[0])
+	}
+	return result
+}
+
+func (ab *AlphaBeta) PublishBestMove(m Move) {
+	ab.bestMove.Store(&m)
+}
+
+// FormatPV writes the principal variation as the pairs of moves of each depth
+func FormatPV(pv []Move) string {
+	pairs := []string{}
+	for i := 0; i < len(pv); i += 2 {
+		pair := pv[i].String()
+		if i+1 < len(pv) {
+			pair += " ; " + pv[i+1].String()
+		}
+		pairs = append(pairs, pair)
+	}
+	return strings.Join(pairs, " | ")
+}
+
+type AlphaBetaStrategy struct {
+	search *AlphaBeta
+}
+
+func (abs *AlphaBetaStrategy) Name() string {
+	return "alphabeta"
+}
+
+func (abs *AlphaBetaStrategy) Reset() {
+	abs.search = newAlphaBeta()
+}
+
+func (abs *AlphaBetaStrategy) Choose(s State, budget Budget) Move {
+	return abs.search.Search(s, budget).pv[0]
+}
+
+func (abs *AlphaBetaStrategy) Restart(fallback Move) {
+	abs.search.interrupted.Store(false)
+	abs.search.PublishBestMove(fallback)
+}
+
+func (abs *AlphaBetaStrategy) Stop() {
+	abs.search.interrupted.Store(true)
+}
+
+// GetBestMove returns the move of the last finished depth, it can be called from another goroutine
+func (abs *AlphaBetaStrategy) GetBestMove() Move {
+	if m := abs.search.bestMove.Load(); m != nil {
+		return *m
+	}
+	return Move{code: WAIT}
+}
+
+// Played has nothing to follow, every search starts from the state it is given
+func (abs *AlphaBetaStrategy) Played(s State, m Move) {}
+
+func init() {
+	RegisterStrategy("alphabeta", func(seed int64) Strategy { return &AlphaBetaStrategy{search: newAlphaBeta()} })
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*				ANALYSIS SHELL					*/
+/*												*/
+/************************************************/
+
+const analyzeHelp = `commands:
+  load <replay> [turn]    load a position from a replay file (first turn by default)
+  load <state>            load a position written in state notation, with a map or on the loaded one
+  state                   write the position in state notation
+  canonical               write the canonical form of the position and its number of symmetries
+  turn <n>                go to turn n of the loaded replay
+  show                    draw the board
+  moves [player|opponent] list the legal moves of a side (player by default)
+  play <move> ; <move>    play a player move and an opponent move, by text or by number
+  undo                    go back to the position before the last play
+  search <ms> [n]         search the position for ms milliseconds and print the n best moves of each side
+  iterate <count> [n]     search the position for a fixed number of iterations, same output as search
+  alphabeta <ms>          search the position with alpha-beta and print the principal variation
+  nash [depth]            print the equilibrium of the matrix game of the position (depth 1 by default)
+  seed [n]                set the seed of the following searches, or print it
+  help                    print this help
+  quit                    leave the shell`
+
+type analyzer struct {
+	out     io.Writer
+	color   bool
+	replay  *Replay
+	state   State
+	loaded  bool
+	history []State
+	seed    int64
+}
+
+// runAnalyze opens an interactive shell on the positions of a replay or written in state notation
+func runAnalyze(args []string) {
+	flags := flag.NewFlagSet("analyze", flag.ExitOnError)
+	color := flags.Bool("color", false, "use ANSI colors when drawing the board")
+	seed := flags.Int64("seed", time.Now().UnixNano(), "seed of the searches")
+	gameFlags := addGameFlags(flags)
+	flags.Parse(args)
+
+	loadGameFlags(gameFlags)
+
+	a := &analyzer{out: os.Stdout, color: *color, seed: *seed}
+	if flags.NArg() > 0 {
+		a.exec("load " + strings.Join(flags.Args(), " "))
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Fprint(a.out, "> ")
+	for scanner.Scan() {
+		if !a.exec(scanner.Text()) {
+			return
+		}
+		fmt.Fprint(a.out, "> ")
+	}
+}
+
+// exec runs one command line, it returns false once the shell must be left
+func (a *analyzer) exec(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return true
+	}
+	command, args := fields[0], fields[1:]
+
+	if command != "load" && command != "help" && command != "quit" && !a.loaded {
+		fmt.Fprintln(a.out, "no position loaded")
+		return true
+	}
+
+	var err error
+	switch command {
+	case "load":
+		err = a.load(args)
+	case "turn":
+		err = a.goToTurn(args)
+	case "show":
+		RenderBoard(a.out, a.state, a.color)
+	case "state":
+		fmt.Fprintln(a.out, FormatState(a.state, true))
+	case "canonical":
+		fmt.Fprintf(a.out, "%s  (%d symmetries)\n", a.state.GetCanonicalKey(), len(a.state.GetSymmetries()))
+	case "moves":
+		err = a.listMoves(args)
+	case "play":
+		err = a.play(strings.TrimPrefix(strings.TrimSpace(line), "play"))
+	case "undo":
+		if len(a.history) == 0 {
+			err = fmt.Errorf("nothing to undo")
+			break
+		}
+		a.state = a.history[len(a.history)-1]
+		a.history = a.history[:len(a.history)-1]
+	case "search", "iterate":
+		err = a.search(command, args)
+	case "alphabeta":
+		err = a.searchAlphaBeta(args)
+	case "nash":
+		err = a.solveNash(args)
+	case "seed":
+		if len(args) == 0 {
+			fmt.Fprintln(a.out, "seed", a.seed)
+			break
+		}
+		a.seed, err = strconv.ParseInt(args[0], 10, 64)
+	case "help":
+		fmt.Fprintln(a.out, analyzeHelp)
+	case "quit", "exit":
+		return false
+	default:
+		err = fmt.Errorf("unknown command %q, try help", command)
+	}
+	if err != nil {
+		fmt.Fprintln(a.out, "error:", err)
+	}
+	return true
+}
+
+func (a *analyzer) load(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: load <replay> [turn] or load <state>")
+	}
+	if len(args) > 2 {
+		return a.loadState(strings.Join(args, " "))
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	replay, err := ReadReplay(f)
+	if err != nil {
+		return err
+	}
+	if len(replay.Turns) == 0 {
+		return fmt.Errorf("the replay has no turn")
+	}
+	rules = replay.Rules
+	if err := LoadMapRecord(replay.Cells); err != nil {
+		return err
+	}
+	a.replay = replay
+	if len(args) == 1 {
+		return a.goToTurn([]string{"0"})
+	}
+	return a.goToTurn(args[1:])
+}
+
+func (a *analyzer) loadState(str string) error {
+	if !a.loaded && len(strings.Fields(str)) < 7 {
+		return fmt.Errorf("no map loaded, the state needs a map descriptor")
+	}
+	s, err := ParseState(str)
+	if err != nil {
+		return err
+	}
+	a.state = s
+	a.loaded = true
+	a.history = nil
+	RenderBoard(a.out, a.state, a.color)
+	return nil
+}
+
+func (a *analyzer) goToTurn(args []string) error {
+	if a.replay == nil {
+		return fmt.Errorf("no replay loaded")
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("usage: turn <n>")
+	}
+	turn, err := strconv.Atoi(args[0])
+	if err != nil || turn < 0 || turn >= len(a.replay.Turns) {
+		return fmt.Errorf("turn must be between 0 and %d", len(a.replay.Turns)-1)
+	}
+	a.state = a.replay.Turns[turn].State.State()
+	a.loaded = true
+	a.history = nil
+	t := a.replay.Turns[turn]
+	fmt.Fprintf(a.out, "turn %d: player played %q, opponent played %q\n", turn, t.PlayerMove, t.OpponentMove)
+	RenderBoard(a.out, a.state, a.color)
+	return nil
+}
+
+func getPlayerCode(args []string) (int, error) {
+	if len(args) == 0 {
+		return PLAYER, nil
+	}
+	switch args[0] {
+	case "player", "p":
+		return PLAYER, nil
+	case "opponent", "o":
+		return OPPONENT, nil
+	}
+	return 0, fmt.Errorf("unknown side %q", args[0])
+}
+
+func (a *analyzer) listMoves(args []string) error {
+	playerCode, err := getPlayerCode(args)
+	if err != nil {
+		return err
+	}
+	for i, m := range a.state.GetLegalMoves(playerCode) {
+		fmt.Fprintf(a.out, "%3d  %s\n", i, m)
+	}
+	return nil
+}
+
+// ParseMoveChoice reads a move of playerCode either as text or as its number in the list of legal moves
+func ParseMoveChoice(s State, str string, playerCode int) (Move, error) {
+	moves := s.GetLegalMoves(playerCode)
+	if i, err := strconv.Atoi(strings.TrimSpace(str)); err == nil {
+		if i < 0 || i >= len(moves) {
+			return Move{}, fmt.Errorf("move number must be between 0 and %d", len(moves)-1)
+		}
+		return moves[i], nil
+	}
+	m, err := ParseMove(str)
+	if err != nil {
+		return Move{}, err
+	}
+	if !s.IsLegal(m, playerCode) {
+		return Move{}, fmt.Errorf("%s is not legal", m)
+	}
+	return m, nil
+}
+
+func (a *analyzer) play(args string) error {
+	parts := strings.Split(args, ";")
+	if len(parts) != 2 {
+		return fmt.Errorf("usage: play <player move> ; <opponent move>")
+	}
+	playerMove, err := ParseMoveChoice(a.state, parts[0], PLAYER)
+	if err != nil {
+		return fmt.Errorf("player: %v", err)
+	}
+	opponentMove, err := ParseMoveChoice(a.state, parts[1], OPPONENT)
+	if err != nil {
+		return fmt.Errorf("opponent: %v", err)
+	}
+	a.history = append(a.history, a.state)
+	a.state = a.state.Play(playerMove, opponentMove)
+	RenderBoard(a.out, a.state, a.color)
+	return nil
+}
+
+func (a *analyzer) search(command string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: search <ms> [n] or iterate <count> [n]")
+	}
+	amount, err := strconv.Atoi(args[0])
+	if err != nil || amount <= 0 {
+		return fmt.Errorf("bad budget %q", args[0])
+	}
+	budget := Budget{duration: time.Duration(amount) * time.Millisecond}
+	if command == "iterate" {
+		budget = Budget{iterations: amount}
+	}
+	nbShown := 5
+	if len(args) > 1 {
+		if nbShown, err = strconv.Atoi(args[1]); err != nil {
+			return fmt.Errorf("bad number of moves %q", args[1])
+		}
+	}
+
+	gameTree := newGameTree(a.seed)
+	gameTree.Update(a.state)
+	bestMove := gameTree.Compute(budget)
+	fmt.Fprintf(a.out, "%d iterations, best move %s\n", gameTree.root.nbVisit, bestMove)
+	for _, playerCode := range []int{PLAYER, OPPONENT} {
+		name := "player"
+		if playerCode == OPPONENT {
+			name = "opponent"
+		}
+		fmt.Fprintln(a.out, name+":")
+		for i, st := range gameTree.GetStats(playerCode) {
+			if i == nbShown {
+				break
+			}
+			fmt.Fprintf(a.out, "  %-12s visits %7d  value %.3f\n", st.move, st.visits, st.value)
+		}
+	}
+	return nil
+}
+
+func (a *analyzer) searchAlphaBeta(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: alphabeta <ms>")
+	}
+	ms, err := strconv.Atoi(args[0])
+	if err != nil || ms <= 0 {
+		return fmt.Errorf("bad budget %q", args[0])
+	}
+	result := newAlphaBeta().Search(a.state, Budget{duration: time.Duration(ms) * time.Millisecond})
+	fmt.Fprintf(a.out, "depth %d, %d nodes, value %.2f\n", result.depth, result.nbNodes, result.value)
+	fmt.Fprintln(a.out, "pv:", FormatPV(result.pv))
+	return nil
+}
+
+func (a *analyzer) solveNash(args []string) error {
+	depth := 1
+	if len(args) > 0 {
+		var err error
+		if depth, err = strconv.Atoi(args[0]); err != nil || depth < 1 {
+			return fmt.Errorf("bad depth %q", args[0])
+		}
+	}
+	root := newNode(a.state, nil)
+	payoff, _ := root.GetPayoffMatrix(depth, func() bool { return false })
+	playerStrategy, opponentStrategy, value := SolveMatrixGame(payoff, NASH_ITERATIONS)
+	fmt.Fprintf(a.out, "%dx%d game, value %.2f\n", len(root.playerMoveList), len(root.opponentMoveList), value)
+	for _, side := range []struct {
+		name     string
+		moves    []Move
+		strategy []float64
+	}{{"player", root.playerMoveList, playerStrategy}, {"opponent", root.opponentMoveList, opponentStrategy}} {
+		fmt.Fprintln(a.out, side.name+":")
+		for _, i := range GetSupport(side.strategy) {
+			fmt.Fprintf(a.out, "  %-12s %.3f\n", side.moves[i], side.strategy[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+/************************************************/
+/*												*/
+/*				BOARD GEOMETRY					*/
+/*												*/
+/************************************************/
+
+const (
+	// the standard board has 37 cells, the engine handles any radius up to MAX_BOARD_RADIUS
+	DEFAULT_BOARD_RADIUS = 3
+	MIN_BOARD_RADIUS     = 2
+
+	// the arrays of the state are sized for the biggest board, which is that of the build
+	MAX_CELLS = 1 + 3*MAX_BOARD_RADIUS*(MAX_BOARD_RADIUS+1)
+)
+
+// directionVectors stores the axial (q, r) offset of each direction
+var directionVectors = [6][2]int{
+	EAST: {1, 0},
+	NE:   {1, -1},
+	NW:   {0, -1},
+	WEST: {-1, 0},
+	SW:   {-1, 1},
+	SE:   {0, 1},
+}
+
+// cellCoords stores the axial (q, r) coordinates of every cell, the center being (0, 0)
+var cellCoords [MAX_CELLS][2]int
+
+// boardRadius is the radius of the loaded board, the ring of its outermost cells
+var boardRadius int
+
+// GetNbCells returns the number of cells of a board of the given radius
+func GetNbCells(radius int) int {
+	return 1 + 3*radius*(radius+1)
+}
+
+// GetRadius returns the radius of the board of nbCells cells, -1 if no supported board has that many
+func GetRadius(nbCells int) int {
+	for radius := MIN_BOARD_RADIUS; radius <= MAX_BOARD_RADIUS; radius++ {
+		if GetNbCells(radius) == nbCells {
+			return radius
+		}
+	}
+	return -1
+}
+
+// CheckBoardRadius returns an error if the map generator cannot build a board of that radius
+func CheckBoardRadius(radius int) error {
+	if radius < MIN_BOARD_RADIUS || radius > MAX_BOARD_RADIUS {
+		return fmt.Errorf("board radius must be between %d and %d, not %d", MIN_BOARD_RADIUS, MAX_BOARD_RADIUS, radius)
+	}
+	return nil
+}
+
+// GetBoardCoords returns the axial coordinates of every cell of a board of the given radius,
+// using the referee's numbering: 0 is the center cell, the next cells spiral outwards
+// ring by ring, each ring starting east of the center and turning counterclockwise
+func GetBoardCoords(radius int) [][2]int {
+	coords := [][2]int{{0, 0}}
+	for ring := 1; ring <= radius; ring++ {
+		q, r := directionVectors[EAST][0]*ring, directionVectors[EAST][1]*ring
+		for side := 0; side < 6; side++ {
+			for j := 0; j < ring; j++ {
+				coords = append(coords, [2]int{q, r})
+				q += directionVectors[(side+2)%6][0]
+				r += directionVectors[(side+2)%6][1]
+			}
+		}
+	}
+	return coords
+}
+
+// GetNeighbours returns the neighbours of every cell for the given coordinates (-1 if out of bounds)
+func GetNeighbours(coords [][2]int) [][6]int {
+	indexes := map[[2]int]int{}
+	for i, c := range coords {
+		indexes[c] = i
+	}
+	neighbours := make([][6]int, len(coords))
+	for i, c := range coords {
+		for dir, v := range directionVectors {
+			neigh, ok := indexes[[2]int{c[0] + v[0], c[1] + v[1]}]
+			if !ok {
+				neigh = -1
+			}
+			neighbours[i][dir] = neigh
+		}
+	}
+	return neighbours
+}
+
+// GetRing returns the distance between the cell and the center of the board
+func GetRing(cell int) int {
+	q, r := cellCoords[cell][0], cellCoords[cell][1]
+	return (abs(q) + abs(r) + abs(q+r)) / 2
+}
+
+// GetDistance returns the number of steps between two cells, whatever is on the way
+func GetDistance(a int, b int) int {
+	q, r := cellCoords[a][0]-cellCoords[b][0], cellCoords[a][1]-cellCoords[b][1]
+	return (abs(q) + abs(r) + abs(q+r)) / 2
+}
+
+// GetOppositeCell returns the cell symmetric to cell through the center of the board
+func GetOppositeCell(cell int) int {
+	for i, c := range cellCoords[:nbCells] {
+		if c[0] == -cellCoords[cell][0] && c[1] == -cellCoords[cell][1] {
+			return i
+		}
+	}
+	return -1
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+/************************************************/
+/*												*/
+/*				MAP LOADING AND GENERATION		*/
+/*												*/
+/************************************************/
+
+// richnessLevelMap stores the richness of each cell as given in the game input (0-3, 0 if unfertile)
+var richnessLevelMap [MAX_CELLS]int
+
+// ToRichnessValue converts a richness read from the game input (0-3)
+// to the value stored in richnessMap (its bonus, -1 if unfertile)
+func ToRichnessValue(richness int) int {
+	if richness <= 0 || richness > 3 {
+		return -1
+	}
+	return rules.RichnessBonus[richness]
+}
+
+// LoadMap sets nbCells, richnessMap, neighboursMap and cellCoords from the game input richnesses
+// and neighbours
+func LoadMap(richness []int, neighbours [][6]int) error {
+	if len(richness) > MAX_CELLS {
+		return fmt.Errorf("the board has %d cells, the biggest one handled has %d", len(richness), MAX_CELLS)
+	}
+	nbCells = len(richness)
+	for i := 0; i < MAX_CELLS; i++ {
+		if i >= nbCells {
+			richnessLevelMap[i], richnessMap[i], neighboursMap[i] = 0, -1, [6]int{-1, -1, -1, -1, -1, -1}
+			continue
+		}
+		for _, neigh := range neighbours[i] {
+			if neigh < -1 || neigh >= nbCells {
+				return fmt.Errorf("cell %d has a neighbour %d out of the board", i, neigh)
+			}
+		}
+		richnessLevelMap[i] = richness[i]
+		richnessMap[i] = ToRichnessValue(richness[i])
+		neighboursMap[i] = neighbours[i]
+	}
+	LoadCoords()
+	LoadSymmetries()
+	return nil
+}
+
+// LoadCoords computes cellCoords and boardRadius from neighboursMap by walking from the center cell
+func LoadCoords() {
+	done := [MAX_CELLS]bool{true}
+	cellCoords[0] = [2]int{0, 0}
+	toDoCells := []int{0}
+	for len(toDoCells) > 0 {
+		cell := toDoCells[0]
+		toDoCells = toDoCells[1:]
+		for dir, neigh := range neighboursMap[cell] {
+			if neigh < 0 || done[neigh] {
+				continue
+			}
+			done[neigh] = true
+			cellCoords[neigh] = [2]int{cellCoords[cell][0] + directionVectors[dir][0], cellCoords[cell][1] + directionVectors[dir][1]}
+			toDoCells = append(toDoCells, neigh)
+		}
+	}
+	boardRadius = 0
+	for i := 0; i < nbCells; i++ {
+		boardRadius = max(boardRadius, GetRing(i))
+	}
+}
+
+// GenerateMap returns the richness (0-3) and neighbours of every cell of a random map of the given
+// radius, the way the referee builds them: richness decreases towards the edges and a few
+// unusable cells are placed symmetrically through the center
+func GenerateMap(rng *rand.Rand, radius int) ([]int, [][6]int) {
+	coords := GetBoardCoords(radius)
+	neighbours := GetNeighbours(coords)
+	copy(cellCoords[:], coords)
+	nbCells = len(coords)
+
+	richness := make([]int, len(coords))
+	for i := range coords {
+		switch GetRing(i) {
+		case radius:
+			richness[i] = 1
+		case radius - 1:
+			richness[i] = 2
+		default:
+			richness[i] = 3
+		}
+	}
+
+	// up to 10 holes on the standard board, as many per cell on the others
+	nbHoles := rng.Intn(len(coords)*10/GetNbCells(DEFAULT_BOARD_RADIUS) + 1)
+	for i := 0; i < nbHoles; i++ {
+		cell := rng.Intn(len(coords))
+		if cell == 0 {
+			continue
+		}
+		richness[cell] = 0
+		richness[GetOppositeCell(cell)] = 0
+	}
+	return richness, neighbours
+}
+
+// NewGame loads a random map of the given radius (checked by CheckBoardRadius) and returns the
+// state of its first day: each player starts with two small trees on the outer ring, placed symmetrically
+func NewGame(rng *rand.Rand, radius int) State {
+	richness, neighbours := GenerateMap(rng, radius)
+	if err := LoadMap(richness, neighbours); err != nil {
+		panic(err)
+	}
+
+	s := newState()
+	s.nutrients = rules.StartingNutrients
+
+	outerRing := []int{}
+	for i := 0; i < nbCells; i++ {
+		if GetRing(i) == boardRadius {
+			outerRing = append(outerRing, i)
+		}
+	}
+	rng.Shuffle(len(outerRing), func(i, j int) {
+		outerRing[i], outerRing[j] = outerRing[j], outerRing[i]
+	})
+
+	nbPlaced := 0
+	for _, cell := range outerRing {
+		opposite := GetOppositeCell(cell)
+		if nbPlaced == 2 || richnessMap[cell] < 0 || s.treeMap[cell] != -1 || s.treeMap[opposite] != -1 {
+			continue
+		}
+		s = s.AddTree(cell, rules.StartingTreeSize, PLAYER, false)
+		s = s.AddTree(opposite, rules.StartingTreeSize, OPPONENT, false)
+		nbPlaced++
+	}
+	// IsEqual compares the tree lists, which are always kept sorted
+	for i := 0; i < 2; i++ {
+		sort.Ints(s.activeTreesIndex[i])
+	}
+	s = s.UpdateGrowCosts()
+	s = s.UpdateShadows()
+	s.sun = s.GetSunPoints()
+	return s
+}
@@ -0,0 +1,7 @@
+//go:build !bigboard
+
+package main
+
+// The states are copied on every move of the searches, their arrays are sized for the standard
+// board. Build with -tags bigboard to play on the boards of radius 4 and 5.
+const MAX_BOARD_RADIUS = 3
@@ -0,0 +1,6 @@
+//go:build bigboard
+
+package main
+
+// The boards of radius 4 and 5, for stress tests: every state carries arrays of 91 cells.
+const MAX_BOARD_RADIUS = 5
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*				OPENING BOOK					*/
+/*												*/
+/************************************************/
+
+// The opening book stores the best moves of the first days, found by a long self-play search on
+// generated maps. It is keyed by the state notation of the position, without the map: the symmetries
+// of a position are those that keep the sun of the remaining days, in the first days there is only
+// the identity and canonical forms would merge nothing. Out of the holes, the maps of a radius
+// are all the same: a position of the book comes back on most maps, whose holes may make the book
+// move illegal, then the bot searches. The book command writes it as the Go source file book_data.go:
+//
+//	go run . book -maps 300 -seed 1 -days 2 -iterations 2000 -out book_data.go
+//
+// and measures how often a bot finds its position in the book on fresh maps with -hits:
+//
+//	go run . book -hits 100 -seed 100000 -iterations 1000
+//
+// The bot plays the book move, when there is one, before searching.
+
+// useOpeningBook is turned off with -book=false, and while building a new book
+var useOpeningBook = true
+
+// GetBookKey returns the key of the position in the book
+func GetBookKey(s State) string {
+	return FormatState(s, false)
+}
+
+// GetBookMove returns the move of the opening book for PLAYER in s, if the book has the position,
+// was built with the rules and the board radius of the game and its move is legal.
+// The key has no map: the generator gives every cell of a ring the same richness, so maps of the
+// same radius only differ by their holes. A hole takes a cell away from the seeds, it changes
+// nothing else in the first days, when the trees are on the outer ring and the book only grows
+// them or seeds around them: a book move that is still legal was found for the same trees on the
+// same richness, and keeping the holes in the key would leave the book almost no position to find.
+func GetBookMove(s State) (Move, bool) {
+	if !useOpeningBook || len(openingBook) == 0 || rules != leagueRules[openingBookLeague] || boardRadius != openingBookRadius {
+		return Move{}, false
+	}
+	str, ok := openingBook[GetBookKey(s)]
+	if !ok {
+		return Move{}, false
+	}
+	m, err := ParseMove(str)
+	if err != nil {
+		return Move{}, false
+	}
+	if !s.IsLegal(m, PLAYER) {
+		return Move{}, false
+	}
+	return m, true
+}
+
+// runBook builds an opening book: on every map, both sides play the first days with a long search
+// from every position that is not in the book yet
+func runBook(args []string) {
+	flags := flag.NewFlagSet("book", flag.ExitOnError)
+	nbMaps := flags.Int("maps", 20, "number of generated maps")
+	seed := flags.Int64("seed", 1, "seed of the first map, the next maps take the next seeds")
+	nbDays := flags.Int("days", 2, "the book covers the positions of the days before this one")
+	iterations := flags.Int("iterations", 20000, "search iterations per position")
+	radius := flags.Int("radius", DEFAULT_BOARD_RADIUS, fmt.Sprintf("radius of the generated boards (%d to %d)", MIN_BOARD_RADIUS, MAX_BOARD_RADIUS))
+	league := flags.String("league", DEFAULT_LEAGUE, "league the book is built for: "+strings.Join(GetLeagueNames(), ", "))
+	outPath := flags.String("out", "book_data.go", "Go file the book is written to")
+	nbHitMaps := flags.Int("hits", 0, "measure the hit rate of the built-in book on this many maps instead of building one")
+	flags.Parse(args)
+
+	if _, ok := leagueRules[*league]; !ok {
+		fmt.Fprintf(os.Stderr, "unknown league %q, known ones are %s\n", *league, strings.Join(GetLeagueNames(), ", "))
+		os.Exit(2)
+	}
+	LoadRules(*league)
+	if err := CheckBoardRadius(*radius); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *nbHitMaps > 0 {
+		MeasureBookHits(*nbHitMaps, *seed, *radius, *iterations)
+		return
+	}
+	useOpeningBook = false
+
+	book := map[string]string{}
+	t0 := time.Now()
+	for i := 0; i < *nbMaps; i++ {
+		mapSeed := *seed + int64(i)
+		s := NewGame(rand.New(rand.NewSource(mapSeed)), *radius)
+		bots := [2]*Bot{PLAYER: newBot(mapSeed), OPPONENT: newBot(mapSeed + 1)}
+
+		for s.day < *nbDays && s.day < rules.NbDays {
+			moves := [2]Move{{code: WAIT}, {code: WAIT}}
+			for _, playerCode := range []int{PLAYER, OPPONENT} {
+				if s.isWaiting[playerCode] == 1 {
+					continue
+				}
+				view := s.GetView(playerCode)
+				key := GetBookKey(view)
+				if str, ok := book[key]; ok {
+					if m, err := ParseMove(str); err == nil && view.IsLegal(m, PLAYER) {
+						moves[playerCode] = m
+						continue
+					}
+				}
+				moves[playerCode] = bots[playerCode].Think(view, Budget{iterations: *iterations})
+				if _, ok := book[key]; !ok {
+					book[key] = moves[playerCode].String()
+				}
+			}
+			s = s.Play(moves[PLAYER], moves[OPPONENT])
+		}
+		fmt.Fprintf(os.Stderr, "map %d/%d: %d positions, %s\n", i+1, *nbMaps, len(book), time.Since(t0).Round(time.Second))
+	}
+
+	if err := WriteBook(*outPath, book, *league, *radius); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// WriteBook writes the book as the gofmt-ed Go source of openingBook, openingBookLeague and openingBookRadius
+func WriteBook(path string, book map[string]string, league string, radius int) error {
+	keys := []string{}
+	for key := range book {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by the book command; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package main")
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "const openingBookLeague = %q\n", league)
+	fmt.Fprintf(&b, "const openingBookRadius = %d\n", radius)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var openingBook = map[string]string{")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "\t%q: %q,\n", key, book[key])
+	}
+	fmt.Fprintln(&b, "}")
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, source, 0644)
+}
+
+// MeasureBookHits plays the MCTS bot, searching iterations per move, against the greedy strategy on
+// nbMaps maps generated from seed on and prints how many of the moves of the bot in the days the book
+// covers were in the book. Facing other opponents, the bot leaves the book after its first moves.
+func MeasureBookHits(nbMaps int, seed int64, radius int, iterations int) {
+	lastBookDay := -1
+	for key := range openingBook {
+		day := 0
+		fmt.Sscan(key, &day)
+		lastBookDay = max(lastBookDay, day)
+	}
+
+	nbMoves, nbHits, nbFirstHits := 0, 0, 0
+	for i := 0; i < nbMaps; i++ {
+		mapSeed := seed + int64(i)
+		s := NewGame(rand.New(rand.NewSource(mapSeed)), radius)
+		bot := newBot(mapSeed)
+		isFirstMove := true
+		for s.day <= lastBookDay {
+			moves := [2]Move{{code: WAIT}, {code: WAIT}}
+			if s.isWaiting[PLAYER] == 0 {
+				if _, ok := GetBookMove(s); ok {
+					nbHits++
+					if isFirstMove {
+						nbFirstHits++
+					}
+				}
+				nbMoves++
+				isFirstMove = false
+				moves[PLAYER] = bot.Think(s, Budget{iterations: iterations})
+			}
+			if s.isWaiting[OPPONENT] == 0 {
+				moves[OPPONENT] = GreedyStrategy{}.Choose(s.GetView(OPPONENT), Budget{})
+			}
+			s = s.Play(moves[PLAYER], moves[OPPONENT])
+		}
+	}
+	fmt.Printf("%d positions in the book, up to day %d\n", len(openingBook), lastBookDay)
+	fmt.Printf("%d of %d first moves in the book (%.1f%%), %d of %d moves (%.1f%%)\n",
+		nbFirstHits, nbMaps, 100*float64(nbFirstHits)/float64(nbMaps),
+		nbHits, nbMoves, 100*float64(nbHits)/float64(max(nbMoves, 1)))
+}
@@ -0,0 +1,1110 @@
+// Code generated by the book command; DO NOT EDIT.
+
+package main
+
+const openingBookLeague = "bronze"
+const openingBookRadius = 3
+
+var openingBook = map[string]string{
+	"0 20 0/2 0/0 00 22P1,23O1,31O1,32P1":                          "SEED 22 21",
+	"0 20 0/2 0/0 00 23P1,24O1,32O1,33P1":                          "WAIT",
+	"0 20 0/2 0/0 00 24P1,25O1,33O1,34P1":                          "WAIT",
+	"0 20 0/2 0/0 01 21p0,22p1,23O1,31O1,32P1":                     "WAIT",
+	"0 20 1/0 0/0 01 11p0,23O1,24p1,31p0,32p1,33O1":                "WAIT",
+	"0 20 1/1 0/0 00 14o0,20p0,21p1,24p1,25p0,30o1,33o1,34o0":      "WAIT",
+	"0 20 1/1 0/0 00 14p0,20o0,21o1,24o1,25o0,30p1,33p1,34p0":      "WAIT",
+	"0 20 1/1 0/0 00 15o0,22p0,23p1,25o1,26o0,32o1,34p1,35p0":      "WAIT",
+	"0 20 1/1 0/0 00 15p0,22o0,23o1,25p1,26p0,32p1,34o1,35o0":      "WAIT",
+	"0 20 1/1 0/0 00 16o0,19p1,23p1,24p0,27o0,28o1,32o1,36p0":      "WAIT",
+	"0 20 1/1 0/0 00 16p0,19o1,23o1,24o0,27p0,28p1,32p1,36o0":      "WAIT",
+	"0 20 1/1 0/0 00 19o0,25o0,26o1,27p1,28p0,34p0,35p1,36o1":      "WAIT",
+	"0 20 1/1 0/0 00 19o1,20o0,21o1,28p1,29p0,30p1,31p0,36o0":      "WAIT",
+	"0 20 1/1 0/0 00 19p0,25p0,26p1,27o1,28o0,34o0,35o1,36p1":      "WAIT",
+	"0 20 1/1 0/0 00 19p1,20p0,21p1,28o1,29o0,30o1,31o0,36p0":      "WAIT",
+	"0 20 1/1 0/0 00 20o0,21o1,23o1,24o0,29p0,30p1,32p1,33p0":      "WAIT",
+	"0 20 1/1 0/0 00 20o0,21o1,24o1,25o0,29p0,30p1,32p0,33p1":      "WAIT",
+	"0 20 1/1 0/0 00 20p0,21p1,23p1,24p0,29o0,30o1,32o1,33o0":      "WAIT",
+	"0 20 1/1 0/0 00 20p0,21p1,24p1,25p0,29o0,30o1,32o0,33o1":      "WAIT",
+	"0 20 1/1 0/0 00 21o0,22o1,26p1,27p0,30p0,31p1,34o0,35o1":      "WAIT",
+	"0 20 1/1 0/0 00 21o0,22o1,27o1,28o0,31p1,32p0,35p0,36p1":      "WAIT",
+	"0 20 1/1 0/0 00 21p0,22p1,26o1,27o0,30o0,31o1,34p0,35p1":      "WAIT",
+	"0 20 1/1 0/0 00 21p0,22p1,27p1,28p0,31o1,32o0,35o0,36o1":      "WAIT",
+	"0 20 1/1 0/0 00 22O1,23O1,31P1,32P1":                          "WAIT",
+	"0 20 1/1 0/0 00 22P1,23P1,31O1,32O1":                          "WAIT",
+	"0 20 1/1 0/0 00 23O1,24O1,32P1,33P1":                          "SEED 33 34",
+	"0 20 1/1 0/0 00 23O1,24o1,25o0,32P1,33p1,34p0":                "WAIT",
+	"0 20 1/1 0/0 00 23P1,24P1,32O1,33O1":                          "SEED 24 25",
+	"0 20 1/1 0/0 00 23P1,24p1,25p0,32O1,33o1,34o0":                "WAIT",
+	"0 20 1/1 0/0 00 24O1,25O1,33P1,34P1":                          "WAIT",
+	"0 20 1/1 0/0 00 24P1,25P1,33O1,34O1":                          "SEED 24 11",
+	"0 20 1/1 0/0 00 7o0,12p0,13p0,18o0,19o1,26p1,28p1,35o1":       "WAIT",
+	"0 20 1/1 0/0 00 7p0,12o0,13o0,18p0,19p1,26o1,28o1,35p1":       "WAIT",
+	"0 20 1/1 0/0 00 8o0,21o1,25p1,26p0,29p0,30p1,34o1,35o0":       "WAIT",
+	"0 20 1/1 0/0 00 8p0,21p1,25o1,26o0,29o0,30o1,34p1,35p0":       "WAIT",
+	"0 20 1/1 0/0 00 9o0,15p0,21o1,24p0,25p1,30p1,34o1,35o0":       "WAIT",
+	"0 20 1/1 0/0 00 9o0,15p0,21o1,25p1,26p0,30p1,34o1,35o0":       "WAIT",
+	"0 20 1/1 0/0 00 9o0,15p0,23o1,25p1,26p0,32p1,34o1,35o0":       "WAIT",
+	"0 20 1/1 0/0 00 9p0,15o0,21p1,24o0,25o1,30o1,34p1,35p0":       "WAIT",
+	"0 20 1/1 0/0 00 9p0,15o0,21p1,25o1,26o0,30o1,34p1,35p0":       "WAIT",
+	"0 20 1/1 0/0 00 9p0,15o0,23p1,25o1,26o0,32o1,34p1,35p0":       "WAIT",
+	"0 20 1/1 0/0 01 11p0,24p1,25P1,33O1,34O1":                     "WAIT",
+	"0 20 1/2 0/0 01 11p0,17o0,19p1,20p0,26p1,28O1,35o1":           "WAIT",
+	"0 20 1/2 0/0 01 11p0,19O1,24p1,27p0,28p1,33O1":                "WAIT",
+	"0 20 1/2 0/0 01 11p0,22p1,23p0,25p1,31O1,33o0,34o1":           "WAIT",
+	"0 20 1/2 0/0 01 12p0,20O1,27p1,29p1,30p0,36O1":                "WAIT",
+	"0 20 1/2 0/0 01 12p0,22p1,23p0,26p1,31o1,32o0,35O1":           "WAIT",
+	"0 20 1/2 0/0 01 12p0,24p0,25p1,27p1,34O1,36O1":                "WAIT",
+	"0 20 1/2 0/0 01 13p0,20O1,21O1,29p1,30p1,31p0":                "WAIT",
+	"0 20 1/2 0/0 01 13p0,20O1,21p1,22p0,29p1,30O1":                "WAIT",
+	"0 20 1/2 0/0 01 13p0,22O1,27p1,30p0,31p1,36O1":                "WAIT",
+	"0 20 1/2 0/0 01 14o0,20p0,21p1,23p0,24p1,30o1,33O1":           "WAIT",
+	"0 20 1/2 0/0 01 14p0,20O1,26p0,27p1,29p1,36O1":                "WAIT",
+	"0 20 1/2 0/0 01 14p0,20O1,26p1,27p0,29p1,35o1,36o0":           "WAIT",
+	"0 20 1/2 0/0 01 14p0,21O1,24O1,30p1,33p1,34p0":                "WAIT",
+	"0 20 1/2 0/0 01 15p0,19o0,23O1,27p1,28p0,32p1,36o1":           "WAIT",
+	"0 20 1/2 0/0 01 15p0,19p1,20p0,23O1,28O1,32p1":                "WAIT",
+	"0 20 1/2 0/0 01 15p0,21O1,27p1,28p0,30p1,36O1":                "WAIT",
+	"0 20 1/2 0/0 01 17o0,19p1,20p0,23p0,24p1,28O1,33o1":           "WAIT",
+	"0 20 1/2 0/0 01 17o0,23p0,24p1,26p0,27p1,33o1,36O1":           "WAIT",
+	"0 20 1/2 0/0 01 17p0,19p0,24O1,27o1,28o0,33p1,36p1":           "WAIT",
+	"0 20 1/2 0/0 01 17p0,21O1,26O1,29p0,30p1,35p1":                "WAIT",
+	"0 20 1/2 0/0 01 17p0,24o1,25o0,27O1,33p1,35p0,36p1":           "WAIT",
+	"0 20 1/2 0/0 01 18p0,19O1,27O1,28p1,29p0,36p1":                "WAIT",
+	"0 20 1/2 0/0 01 18p0,21O1,26O1,29p0,30p1,35p1":                "WAIT",
+	"0 20 1/2 0/0 01 18p0,23O1,26O1,32p1,33p0,35p1":                "WAIT",
+	"0 20 1/2 0/0 01 18p0,23O1,27O1,31p0,32p1,36p1":                "WAIT",
+	"0 20 1/2 0/0 01 19O1,22o0,23o1,27p0,28p1,31p0,32p1":           "WAIT",
+	"0 20 1/2 0/0 01 19O1,24p0,25p1,28p1,29p0,33o0,34o1":           "WAIT",
+	"0 20 1/2 0/0 01 19O1,27O1,28p1,29p0,35p0,36p1":                "WAIT",
+	"0 20 1/2 0/0 01 19o0,25O1,27p1,28p0,33p0,34p1,36o1":           "WAIT",
+	"0 20 1/2 0/0 01 19p0,20p1,22O1,29O1,31p1,32p0":                "WAIT",
+	"0 20 1/2 0/0 01 19p0,20p1,25p1,26p0,28o0,29o1,34O1":           "WAIT",
+	"0 20 1/2 0/0 01 19p1,20p0,22p1,23p0,28O1,31O1":                "WAIT",
+	"0 20 1/2 0/0 01 19p1,24p0,25p1,28O1,34O1,36p0":                "WAIT",
+	"0 20 1/2 0/0 01 20O1,21O1,28p0,29p1,30p1,31p0":                "WAIT",
+	"0 20 1/2 0/0 01 20O1,26O1,29p1,30p0,34p0,35p1":                "WAIT",
+	"0 20 1/2 0/0 01 20p0,21p1,22p0,23p1,30o1,31o0,32O1":           "WAIT",
+	"0 20 1/2 0/0 01 20p0,21p1,22p1,23p0,30O1,31O1":                "WAIT",
+	"0 20 1/2 0/0 01 20p1,21p0,22p0,23p1,29o1,30o0,32O1":           "WAIT",
+	"0 20 1/2 0/0 01 20p1,21p0,23p0,24p1,29o1,30o0,33O1":           "WAIT",
+	"0 20 1/2 0/0 01 21O1,23p1,24p0,29p0,30p1,32O1":                "WAIT",
+	"0 20 1/2 0/0 01 21p1,22p0,25p1,26p0,30O1,34O1":                "WAIT",
+	"0 20 1/2 0/0 01 22O1,24p1,25p0,30p0,31p1,33O1":                "WAIT",
+	"0 20 1/2 0/0 01 22p0,23p1,25o1,26o0,32O1,34p1,35p0":           "WAIT",
+	"0 20 1/2 0/0 01 22p1,23p0,24p1,25p0,31O1,33O1":                "WAIT",
+	"0 20 1/2 0/0 01 23p0,24p1,26O1,33O1,34p0,35p1":                "WAIT",
+	"0 20 1/2 0/0 01 7p0,13o0,17p0,20p1,26O1,29o1,35p1":            "WAIT",
+	"0 20 1/2 0/0 01 7p0,13o0,20p1,26O1,29o1,34p0,35p1":            "WAIT",
+	"0 20 1/2 0/0 01 7p0,13p0,19O1,27O1,28p1,36p1":                 "WAIT",
+	"0 20 1/2 0/0 01 7p0,17p0,24O1,27O1,33p1,36p1":                 "WAIT",
+	"0 20 1/2 0/0 01 7p0,19p1,22O1,28O1,31p1,32p0":                 "WAIT",
+	"0 20 1/2 0/0 01 7p0,19p1,22o0,23o1,28O1,32p1,33p0":            "WAIT",
+	"0 20 1/2 0/0 01 7p0,19p1,25O1,28o1,29o0,34p1,35p0":            "WAIT",
+	"0 20 1/2 0/0 01 7p0,20p1,23O1,29O1,31p0,32p1":                 "WAIT",
+	"0 20 1/2 0/0 01 7p0,20p1,23O1,29O1,32p1,33p0":                 "WAIT",
+	"0 20 1/2 0/0 01 8p0,10p0,20p1,24p1,29O1,33O1":                 "WAIT",
+	"0 20 1/2 0/0 01 8p0,11p0,21p1,25p1,30O1,34O1":                 "WAIT",
+	"0 20 1/2 0/0 01 8p0,21p1,23p1,24p0,30O1,32o1,33o0":            "WAIT",
+	"0 20 1/2 0/0 01 9p0,12p0,15o0,22p1,26p1,31o1,35O1":            "WAIT",
+	"0 20 1/2 0/0 01 9p0,19o0,20o1,21p1,28p0,29p1,30O1":            "WAIT",
+	"0 20 1/2 0/0 01 9p0,21O1,23p1,29p0,30p1,32O1":                 "WAIT",
+	"0 20 1/2 0/0 01 9p0,22p1,24p1,25p0,31O1,33O1":                 "WAIT",
+	"0 20 2/0 0/0 00 22O1,23P1,31P1,32O1":                          "WAIT",
+	"0 20 2/0 0/0 00 23O1,24P1,32P1,33O1":                          "SEED 24 11",
+	"0 20 2/0 0/0 00 24O1,25P1,33P1,34O1":                          "SEED 33 32",
+	"0 20 2/0 0/0 01 11p0,23O1,24p1,32P1,33O1":                     "SEED 32 31",
+	"0 20 2/0 0/0 01 24O1,25P1,32p0,33p1,34O1":                     "WAIT",
+	"0 20 2/2 0/0 00 11o0,17p0,19O1,26o1,28P1,35p1":                "WAIT",
+	"0 20 2/2 0/0 00 11o0,19O1,26o1,28P1,34p0,35p1":                "WAIT",
+	"0 20 2/2 0/0 00 11o0,22O1,25o1,31P1,33p0,34p1":                "WAIT",
+	"0 20 2/2 0/0 00 11p0,17o0,19P1,26p1,28O1,35o1":                "SEED 19 20",
+	"0 20 2/2 0/0 00 11p0,19P1,26p1,28O1,34o0,35o1":                "WAIT",
+	"0 20 2/2 0/0 00 11p0,22P1,25p1,31O1,33o0,34o1":                "SEED 22 23",
+	"0 20 2/2 0/0 00 12o0,15p0,22O1,26o1,31p1,35P1":                "WAIT",
+	"0 20 2/2 0/0 00 12p0,15o0,22P1,26p1,31o1,35O1":                "SEED 22 9",
+	"0 20 2/2 0/0 00 14o0,20p0,21p1,24P1,30o1,33O1":                "SEED 24 25",
+	"0 20 2/2 0/0 00 14p0,20o0,21o1,24O1,30p1,33P1":                "SEED 33 34",
+	"0 20 2/2 0/0 00 17o0,19P1,23p0,24p1,28O1,33o1":                "SEED 19 20",
+	"0 20 2/2 0/0 00 17o0,24P1,26p0,27p1,33o1,36O1":                "SEED 24 23",
+	"0 20 2/2 0/0 00 17o0,24P1,27p1,28p0,33o1,36O1":                "WAIT",
+	"0 20 2/2 0/0 00 17o0,24p1,25p0,27P1,33o1,36O1":                "WAIT",
+	"0 20 2/2 0/0 00 17p0,19O1,23o0,24o1,28P1,33p1":                "WAIT",
+	"0 20 2/2 0/0 00 17p0,24O1,26o0,27o1,33p1,36P1":                "WAIT",
+	"0 20 2/2 0/0 00 17p0,24O1,27o1,28o0,33p1,36P1":                "SEED 36 19",
+	"0 20 2/2 0/0 00 17p0,24o1,25o0,27O1,33p1,36P1":                "SEED 36 35",
+	"0 20 2/2 0/0 00 19O1,20O1,28P1,29P1":                          "SEED 29 30",
+	"0 20 2/2 0/0 00 19O1,20P1,28P1,29O1":                          "WAIT",
+	"0 20 2/2 0/0 00 19O1,21O1,28P1,30P1":                          "SEED 30 31",
+	"0 20 2/2 0/0 00 19O1,21P1,28P1,30O1":                          "WAIT",
+	"0 20 2/2 0/0 00 19O1,22O1,28P1,31P1":                          "WAIT",
+	"0 20 2/2 0/0 00 19O1,22P1,28P1,31O1":                          "WAIT",
+	"0 20 2/2 0/0 00 19O1,22o0,23o1,28P1,31p0,32p1":                "SEED 28 27",
+	"0 20 2/2 0/0 00 19O1,22p0,23p1,28P1,32o1,33o0":                "WAIT",
+	"0 20 2/2 0/0 00 19O1,23O1,28P1,32P1":                          "SEED 32 31",
+	"0 20 2/2 0/0 00 19O1,23P1,28P1,32O1":                          "SEED 28 27",
+	"0 20 2/2 0/0 00 19O1,23o1,24o0,28P1,32p1,33p0":                "WAIT",
+	"0 20 2/2 0/0 00 19O1,24O1,28P1,33P1":                          "SEED 33 17",
+	"0 20 2/2 0/0 00 19O1,24P1,28P1,33O1":                          "SEED 28 27",
+	"0 20 2/2 0/0 00 19O1,25O1,28P1,34P1":                          "WAIT",
+	"0 20 2/2 0/0 00 19O1,25P1,28P1,34O1":                          "SEED 28 29",
+	"0 20 2/2 0/0 00 19O1,25P1,28p1,29p0,33o0,34o1":                "SEED 25 24",
+	"0 20 2/2 0/0 00 19O1,25P1,28p1,29p0,34o1,35o0":                "WAIT",
+	"0 20 2/2 0/0 00 19O1,25p0,26p1,28P1,34o0,35o1":                "WAIT",
+	"0 20 2/2 0/0 00 19O1,26O1,28P1,35P1":                          "SEED 35 34",
+	"0 20 2/2 0/0 00 19O1,26P1,28P1,35O1":                          "SEED 26 25",
+	"0 20 2/2 0/0 00 19O1,27O1,28P1,36P1":                          "SEED 28 29",
+	"0 20 2/2 0/0 00 19O1,27P1,28P1,36O1":                          "WAIT",
+	"0 20 2/2 0/0 00 19P1,20O1,28O1,29P1":                          "WAIT",
+	"0 20 2/2 0/0 00 19P1,20P1,28O1,29O1":                          "WAIT",
+	"0 20 2/2 0/0 00 19P1,21O1,28O1,30P1":                          "WAIT",
+	"0 20 2/2 0/0 00 19P1,21P1,28O1,30O1":                          "SEED 19 36",
+	"0 20 2/2 0/0 00 19P1,22O1,28O1,31P1":                          "SEED 31 32",
+	"0 20 2/2 0/0 00 19P1,22P1,28O1,31O1":                          "SEED 19 20",
+	"0 20 2/2 0/0 00 19P1,22o0,23o1,28O1,32p1,33p0":                "SEED 19 7",
+	"0 20 2/2 0/0 00 19P1,22p0,23p1,28O1,31o0,32o1":                "WAIT",
+	"0 20 2/2 0/0 00 19P1,23O1,28O1,32P1":                          "SEED 19 36",
+	"0 20 2/2 0/0 00 19P1,23P1,28O1,32O1":                          "SEED 23 22",
+	"0 20 2/2 0/0 00 19P1,23p1,24p0,28O1,32o1,33o0":                "WAIT",
+	"0 20 2/2 0/0 00 19P1,24O1,28O1,33P1":                          "WAIT",
+	"0 20 2/2 0/0 00 19P1,24P1,28O1,33O1":                          "SEED 24 23",
+	"0 20 2/2 0/0 00 19P1,25O1,28O1,34P1":                          "SEED 34 33",
+	"0 20 2/2 0/0 00 19P1,25O1,28o1,29o0,33p0,34p1":                "WAIT",
+	"0 20 2/2 0/0 00 19P1,25O1,28o1,29o0,34p1,35p0":                "SEED 19 7",
+	"0 20 2/2 0/0 00 19P1,25P1,28O1,34O1":                          "SEED 25 26",
+	"0 20 2/2 0/0 00 19P1,25o0,26o1,28O1,34p0,35p1":                "WAIT",
+	"0 20 2/2 0/0 00 19P1,26O1,28O1,35P1":                          "SEED 35 34",
+	"0 20 2/2 0/0 00 19P1,26P1,28O1,35O1":                          "SEED 26 11",
+	"0 20 2/2 0/0 00 19P1,27O1,28O1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 19P1,27P1,28O1,36O1":                          "WAIT",
+	"0 20 2/2 0/0 00 19o0,20o1,21P1,28p0,29p1,30O1":                "SEED 21 9",
+	"0 20 2/2 0/0 00 19o0,20o1,25O1,28p0,29p1,34P1":                "WAIT",
+	"0 20 2/2 0/0 00 19o0,23O1,27p1,28p0,32P1,36o1":                "SEED 32 15",
+	"0 20 2/2 0/0 00 19o0,25O1,27P1,33p0,34p1,36o1":                "SEED 27 28",
+	"0 20 2/2 0/0 00 19o0,26O1,27P1,34p0,35p1,36o1":                "SEED 27 28",
+	"0 20 2/2 0/0 00 19o1,21O1,28P1,30p1,31p0,36o0":                "SEED 28 29",
+	"0 20 2/2 0/0 00 19o1,23O1,27p0,28p1,32P1,36o0":                "SEED 32 16",
+	"0 20 2/2 0/0 00 19o1,23P1,27p0,28p1,32O1,36o0":                "WAIT",
+	"0 20 2/2 0/0 00 19p0,20p1,21O1,28o0,29o1,30P1":                "WAIT",
+	"0 20 2/2 0/0 00 19p0,20p1,25P1,28o0,29o1,34O1":                "WAIT",
+	"0 20 2/2 0/0 00 19p0,23P1,27o1,28o0,32O1,36p1":                "WAIT",
+	"0 20 2/2 0/0 00 19p0,25P1,27O1,33o0,34o1,36p1":                "WAIT",
+	"0 20 2/2 0/0 00 19p0,26P1,27O1,34o0,35o1,36p1":                "SEED 26 25",
+	"0 20 2/2 0/0 00 19p1,21P1,28O1,30o1,31o0,36p0":                "SEED 21 20",
+	"0 20 2/2 0/0 00 19p1,23O1,27o0,28o1,32P1,36p0":                "WAIT",
+	"0 20 2/2 0/0 00 19p1,23P1,27o0,28o1,32O1,36p0":                "SEED 23 24",
+	"0 20 2/2 0/0 00 20O1,21O1,29P1,30P1":                          "SEED 29 13",
+	"0 20 2/2 0/0 00 20O1,21P1,29P1,30O1":                          "SEED 29 28",
+	"0 20 2/2 0/0 00 20O1,22O1,29P1,31P1":                          "WAIT",
+	"0 20 2/2 0/0 00 20O1,22P1,29P1,31O1":                          "WAIT",
+	"0 20 2/2 0/0 00 20O1,23O1,29P1,32P1":                          "SEED 29 30",
+	"0 20 2/2 0/0 00 20O1,23P1,29P1,32O1":                          "WAIT",
+	"0 20 2/2 0/0 00 20O1,24O1,29P1,33P1":                          "SEED 29 30",
+	"0 20 2/2 0/0 00 20O1,24P1,29P1,33O1":                          "SEED 29 14",
+	"0 20 2/2 0/0 00 20O1,25O1,29P1,34P1":                          "SEED 29 28",
+	"0 20 2/2 0/0 00 20O1,25P1,29P1,34O1":                          "SEED 29 30",
+	"0 20 2/2 0/0 00 20O1,25P1,29p1,30p0,33o0,34o1":                "WAIT",
+	"0 20 2/2 0/0 00 20O1,25o1,26o0,28p0,29p1,34P1":                "WAIT",
+	"0 20 2/2 0/0 00 20O1,25p1,26p0,29P1,33o0,34o1":                "WAIT",
+	"0 20 2/2 0/0 00 20O1,26O1,29P1,35P1":                          "SEED 29 13",
+	"0 20 2/2 0/0 00 20O1,26P1,29P1,35O1":                          "SEED 29 13",
+	"0 20 2/2 0/0 00 20O1,26p1,27p0,29P1,35o1,36o0":                "SEED 29 14",
+	"0 20 2/2 0/0 00 20O1,27O1,29P1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 20O1,27P1,29P1,36O1":                          "SEED 29 30",
+	"0 20 2/2 0/0 00 20P1,21O1,29O1,30P1":                          "SEED 20 19",
+	"0 20 2/2 0/0 00 20P1,21P1,29O1,30O1":                          "WAIT",
+	"0 20 2/2 0/0 00 20P1,22O1,29O1,31P1":                          "SEED 20 19",
+	"0 20 2/2 0/0 00 20P1,22P1,29O1,31O1":                          "WAIT",
+	"0 20 2/2 0/0 00 20P1,23O1,29O1,32P1":                          "SEED 20 7",
+	"0 20 2/2 0/0 00 20P1,23P1,29O1,32O1":                          "SEED 20 21",
+	"0 20 2/2 0/0 00 20P1,24O1,29O1,33P1":                          "WAIT",
+	"0 20 2/2 0/0 00 20P1,24P1,29O1,33O1":                          "SEED 20 21",
+	"0 20 2/2 0/0 00 20P1,25O1,29O1,34P1":                          "SEED 34 33",
+	"0 20 2/2 0/0 00 20P1,25O1,29o1,30o0,33p0,34p1":                "WAIT",
+	"0 20 2/2 0/0 00 20P1,25P1,29O1,34O1":                          "SEED 25 26",
+	"0 20 2/2 0/0 00 20P1,25o1,26o0,29O1,33p0,34p1":                "WAIT",
+	"0 20 2/2 0/0 00 20P1,25p1,26p0,28o0,29o1,34O1":                "SEED 20 19",
+	"0 20 2/2 0/0 00 20P1,26O1,29O1,35P1":                          "SEED 20 7",
+	"0 20 2/2 0/0 00 20P1,26P1,29O1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 20P1,26o1,27o0,29O1,35p1,36p0":                "WAIT",
+	"0 20 2/2 0/0 00 20P1,27O1,29O1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 20P1,27P1,29O1,36O1":                          "WAIT",
+	"0 20 2/2 0/0 00 20o0,21o1,23O1,30p1,31p0,32P1":                "WAIT",
+	"0 20 2/2 0/0 00 20o0,21o1,24O1,30P1,32p0,33p1":                "SEED 30 29",
+	"0 20 2/2 0/0 00 20o1,21o0,23O1,29p1,30p0,32P1":                "WAIT",
+	"0 20 2/2 0/0 00 20o1,21o0,24O1,29p1,30p0,33P1":                "WAIT",
+	"0 20 2/2 0/0 00 20p0,21p1,23P1,30o1,31o0,32O1":                "SEED 23 22",
+	"0 20 2/2 0/0 00 20p0,21p1,24P1,30O1,32o0,33o1":                "SEED 24 25",
+	"0 20 2/2 0/0 00 20p1,21p0,23P1,29o1,30o0,32O1":                "SEED 23 22",
+	"0 20 2/2 0/0 00 20p1,21p0,24P1,29o1,30o0,33O1":                "SEED 24 23",
+	"0 20 2/2 0/0 00 21O1,22O1,30P1,31P1":                          "WAIT",
+	"0 20 2/2 0/0 00 21O1,22P1,30P1,31O1":                          "SEED 30 29",
+	"0 20 2/2 0/0 00 21O1,23O1,30P1,32P1":                          "SEED 32 33",
+	"0 20 2/2 0/0 00 21O1,23P1,30P1,32O1":                          "SEED 30 29",
+	"0 20 2/2 0/0 00 21O1,23o1,24o0,30P1,32p1,33p0":                "SEED 30 29",
+	"0 20 2/2 0/0 00 21O1,24O1,30P1,33P1":                          "SEED 30 14",
+	"0 20 2/2 0/0 00 21O1,24P1,30P1,33O1":                          "WAIT",
+	"0 20 2/2 0/0 00 21O1,25O1,30P1,34P1":                          "WAIT",
+	"0 20 2/2 0/0 00 21O1,25P1,30P1,34O1":                          "SEED 30 15",
+	"0 20 2/2 0/0 00 21O1,26O1,30P1,35P1":                          "SEED 30 29",
+	"0 20 2/2 0/0 00 21O1,26P1,30P1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 21O1,27P1,30P1,36O1":                          "SEED 30 15",
+	"0 20 2/2 0/0 00 21P1,22O1,30O1,31P1":                          "SEED 21 8",
+	"0 20 2/2 0/0 00 21P1,22P1,30O1,31O1":                          "SEED 21 20",
+	"0 20 2/2 0/0 00 21P1,23O1,30O1,32P1":                          "WAIT",
+	"0 20 2/2 0/0 00 21P1,23P1,30O1,32O1":                          "SEED 23 24",
+	"0 20 2/2 0/0 00 21P1,23p1,24p0,30O1,32o1,33o0":                "SEED 21 20",
+	"0 20 2/2 0/0 00 21P1,24O1,30O1,33P1":                          "WAIT",
+	"0 20 2/2 0/0 00 21P1,24P1,30O1,33O1":                          "SEED 21 20",
+	"0 20 2/2 0/0 00 21P1,25O1,30O1,34P1":                          "SEED 21 9",
+	"0 20 2/2 0/0 00 21P1,25P1,30O1,34O1":                          "SEED 21 8",
+	"0 20 2/2 0/0 00 21P1,26O1,30O1,35P1":                          "WAIT",
+	"0 20 2/2 0/0 00 21P1,26P1,30O1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 21P1,27O1,30O1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 21o0,22o1,25O1,30p0,31p1,34P1":                "WAIT",
+	"0 20 2/2 0/0 00 21o0,22o1,26P1,30p0,31p1,35O1":                "SEED 26 27",
+	"0 20 2/2 0/0 00 21o1,22o0,23O1,30p1,31p0,32P1":                "WAIT",
+	"0 20 2/2 0/0 00 21p0,22p1,25P1,30o0,31o1,34O1":                "WAIT",
+	"0 20 2/2 0/0 00 21p0,22p1,26O1,30o0,31o1,35P1":                "SEED 35 34",
+	"0 20 2/2 0/0 00 21p1,22p0,23P1,30o1,31o0,32O1":                "WAIT",
+	"0 20 2/2 0/0 00 22O1,24O1,31P1,33P1":                          "WAIT",
+	"0 20 2/2 0/0 00 22O1,24P1,31P1,33O1":                          "SEED 24 25",
+	"0 20 2/2 0/0 00 22O1,25O1,31P1,34P1":                          "SEED 31 30",
+	"0 20 2/2 0/0 00 22O1,25P1,31P1,34O1":                          "SEED 31 32",
+	"0 20 2/2 0/0 00 22O1,26O1,31P1,35P1":                          "SEED 31 32",
+	"0 20 2/2 0/0 00 22O1,26P1,31P1,35O1":                          "SEED 31 30",
+	"0 20 2/2 0/0 00 22O1,27O1,31P1,36P1":                          "SEED 36 35",
+	"0 20 2/2 0/0 00 22O1,27P1,31P1,36O1":                          "SEED 27 13",
+	"0 20 2/2 0/0 00 22O1,27o1,28o0,31P1,35p0,36p1":                "SEED 31 32",
+	"0 20 2/2 0/0 00 22P1,24O1,31O1,33P1":                          "WAIT",
+	"0 20 2/2 0/0 00 22P1,24P1,31O1,33O1":                          "SEED 22 9",
+	"0 20 2/2 0/0 00 22P1,25O1,31O1,34P1":                          "SEED 22 23",
+	"0 20 2/2 0/0 00 22P1,25P1,31O1,34O1":                          "SEED 22 21",
+	"0 20 2/2 0/0 00 22P1,26O1,31O1,35P1":                          "SEED 22 21",
+	"0 20 2/2 0/0 00 22P1,26P1,31O1,35O1":                          "SEED 22 23",
+	"0 20 2/2 0/0 00 22P1,27O1,31O1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 22P1,27P1,31O1,36O1":                          "SEED 27 28",
+	"0 20 2/2 0/0 00 22P1,27p1,28p0,31O1,35o0,36o1":                "SEED 22 21",
+	"0 20 2/2 0/0 00 22o1,23o0,25P1,31p1,32p0,34O1":                "WAIT",
+	"0 20 2/2 0/0 00 22o1,23o0,26O1,31p1,32p0,35P1":                "WAIT",
+	"0 20 2/2 0/0 00 22p1,23p0,25O1,31o1,32o0,34P1":                "WAIT",
+	"0 20 2/2 0/0 00 22p1,23p0,26P1,31o1,32o0,35O1":                "SEED 26 12",
+	"0 20 2/2 0/0 00 23O1,25O1,32P1,34P1":                          "WAIT",
+	"0 20 2/2 0/0 00 23O1,25P1,32P1,34O1":                          "SEED 25 26",
+	"0 20 2/2 0/0 00 23O1,25p1,26p0,32P1,34o1,35o0":                "SEED 32 15",
+	"0 20 2/2 0/0 00 23O1,26O1,32P1,35P1":                          "SEED 35 18",
+	"0 20 2/2 0/0 00 23O1,26P1,32P1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 23O1,27O1,32P1,36P1":                          "SEED 36 35",
+	"0 20 2/2 0/0 00 23O1,27P1,32P1,36O1":                          "SEED 27 28",
+	"0 20 2/2 0/0 00 23P1,25O1,32O1,34P1":                          "SEED 34 35",
+	"0 20 2/2 0/0 00 23P1,25P1,32O1,34O1":                          "WAIT",
+	"0 20 2/2 0/0 00 23P1,25o1,26o0,32O1,34p1,35p0":                "SEED 23 22",
+	"0 20 2/2 0/0 00 23P1,26O1,32O1,35P1":                          "WAIT",
+	"0 20 2/2 0/0 00 23P1,26P1,32O1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 23P1,27O1,32O1,36P1":                          "SEED 36 19",
+	"0 20 2/2 0/0 00 23P1,27P1,32O1,36O1":                          "WAIT",
+	"0 20 2/2 0/0 00 24O1,26O1,33P1,35P1":                          "WAIT",
+	"0 20 2/2 0/0 00 24O1,26P1,33P1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 24O1,27O1,33P1,36P1":                          "SEED 33 17",
+	"0 20 2/2 0/0 00 24O1,27P1,33P1,36O1":                          "WAIT",
+	"0 20 2/2 0/0 00 24P1,26O1,33O1,35P1":                          "SEED 35 34",
+	"0 20 2/2 0/0 00 24P1,26P1,33O1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 24P1,27O1,33O1,36P1":                          "SEED 24 25",
+	"0 20 2/2 0/0 00 24P1,27P1,33O1,36O1":                          "SEED 27 28",
+	"0 20 2/2 0/0 00 25O1,26O1,34P1,35P1":                          "SEED 35 36",
+	"0 20 2/2 0/0 00 25O1,26P1,34P1,35O1":                          "SEED 26 27",
+	"0 20 2/2 0/0 00 25O1,27O1,34P1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 25O1,27P1,34P1,36O1":                          "SEED 34 33",
+	"0 20 2/2 0/0 00 25P1,26O1,34O1,35P1":                          "WAIT",
+	"0 20 2/2 0/0 00 25P1,26P1,34O1,35O1":                          "WAIT",
+	"0 20 2/2 0/0 00 25P1,27O1,34O1,36P1":                          "SEED 36 19",
+	"0 20 2/2 0/0 00 25P1,27P1,34O1,36O1":                          "SEED 27 28",
+	"0 20 2/2 0/0 00 26O1,27O1,35P1,36P1":                          "WAIT",
+	"0 20 2/2 0/0 00 26O1,27P1,35P1,36O1":                          "SEED 35 34",
+	"0 20 2/2 0/0 00 26P1,27O1,35O1,36P1":                          "SEED 36 19",
+	"0 20 2/2 0/0 00 26P1,27P1,35O1,36O1":                          "SEED 26 25",
+	"0 20 2/2 0/0 00 7o0,12p0,19o1,26p1,28P1,35O1":                 "SEED 28 13",
+	"0 20 2/2 0/0 00 7o0,13p0,20o1,26P1,29p1,35O1":                 "WAIT",
+	"0 20 2/2 0/0 00 7p0,12o0,19p1,26o1,28O1,35P1":                 "SEED 35 18",
+	"0 20 2/2 0/0 00 7p0,13o0,20p1,26O1,29o1,35P1":                 "SEED 35 34",
+	"0 20 2/2 0/0 00 8o0,21o1,22P1,29p0,30p1,31O1":                 "WAIT",
+	"0 20 2/2 0/0 00 8o0,21o1,22p1,23p0,30P1,31O1":                 "WAIT",
+	"0 20 2/2 0/0 00 8o0,21o1,25p1,26p0,30P1,34O1":                 "SEED 30 29",
+	"0 20 2/2 0/0 00 8p0,21p1,22O1,29o0,30o1,31P1":                 "WAIT",
+	"0 20 2/2 0/0 00 8p0,21p1,22o1,23o0,30O1,31P1":                 "WAIT",
+	"0 20 2/2 0/0 00 8p0,21p1,25o1,26o0,30O1,34P1":                 "SEED 34 35",
+	"0 20 2/2 0/0 00 9o0,15p0,21o1,25P1,30p1,34O1":                 "SEED 25 24",
+	"0 20 2/2 0/0 00 9o0,15p0,22o1,25P1,31p1,34O1":                 "WAIT",
+	"0 20 2/2 0/0 00 9p0,15o0,21p1,25O1,30o1,34P1":                 "SEED 34 35",
+	"0 20 2/2 0/0 00 9p0,15o0,22p1,25O1,31o1,34P1":                 "WAIT",
+	"0 20 2/2 0/0 01 10p0,20P1,24p1,29O1,33O1":                     "SEED 20 8",
+	"0 20 2/2 0/0 01 10p0,22P1,24p1,31O1,33O1":                     "WAIT",
+	"0 20 2/2 0/0 01 11p0,19P1,25p1,28O1,34O1":                     "WAIT",
+	"0 20 2/2 0/0 01 12p0,26p1,27P1,35O1,36O1":                     "WAIT",
+	"0 20 2/2 0/0 01 13p0,20O1,21O1,29p1,30P1":                     "SEED 30 31",
+	"0 20 2/2 0/0 01 13p0,20O1,21P1,29p1,30O1":                     "SEED 21 22",
+	"0 20 2/2 0/0 01 13p0,20O1,26O1,29p1,35P1":                     "WAIT",
+	"0 20 2/2 0/0 01 13p0,22O1,27p1,31P1,36O1":                     "SEED 31 30",
+	"0 20 2/2 0/0 01 14p0,20O1,24P1,29p1,33O1":                     "WAIT",
+	"0 20 2/2 0/0 01 14p0,21O1,24O1,30p1,33P1":                     "SEED 33 34",
+	"0 20 2/2 0/0 01 15p0,21O1,27P1,30p1,36O1":                     "SEED 27 28",
+	"0 20 2/2 0/0 01 16p0,23O1,27O1,32p1,36P1":                     "WAIT",
+	"0 20 2/2 0/0 01 17p0,24O1,27O1,33p1,36P1":                     "SEED 36 7",
+	"0 20 2/2 0/0 01 18p0,21O1,26O1,30P1,35p1":                     "WAIT",
+	"0 20 2/2 0/0 01 18p0,23O1,26O1,32P1,35p1":                     "SEED 32 33",
+	"0 20 2/2 0/0 01 19O1,20O1,28P1,29p1,30p0":                     "WAIT",
+	"0 20 2/2 0/0 01 19O1,21O1,28P1,30p1,31p0":                     "WAIT",
+	"0 20 2/2 0/0 01 19O1,24P1,27p0,28p1,33O1":                     "SEED 24 11",
+	"0 20 2/2 0/0 01 19O1,27O1,28p1,29p0,36P1":                     "SEED 36 35",
+	"0 20 2/2 0/0 01 19P1,22O1,28O1,31p1,32p0":                     "SEED 19 7",
+	"0 20 2/2 0/0 01 19P1,24p0,25p1,28O1,34O1":                     "SEED 19 36",
+	"0 20 2/2 0/0 01 19P1,25O1,28O1,33p0,34p1":                     "WAIT",
+	"0 20 2/2 0/0 01 19P1,25p1,26p0,28O1,34O1":                     "WAIT",
+	"0 20 2/2 0/0 01 19p0,20p1,22O1,29O1,31P1":                     "SEED 31 32",
+	"0 20 2/2 0/0 01 19p1,20p0,22P1,28O1,31O1":                     "SEED 22 23",
+	"0 20 2/2 0/0 01 19p1,20p0,23O1,28O1,32P1":                     "SEED 32 15",
+	"0 20 2/2 0/0 01 20O1,21O1,28p0,29p1,30P1":                     "SEED 30 31",
+	"0 20 2/2 0/0 01 20O1,21O1,29P1,30p1,31p0":                     "WAIT",
+	"0 20 2/2 0/0 01 20O1,26O1,29p1,30p0,35P1":                     "SEED 35 34",
+	"0 20 2/2 0/0 01 20O1,26p0,27p1,29P1,36O1":                     "SEED 29 14",
+	"0 20 2/2 0/0 01 20O1,27P1,29p1,30p0,36O1":                     "SEED 27 12",
+	"0 20 2/2 0/0 01 20O1,27p1,28p0,29P1,36O1":                     "WAIT",
+	"0 20 2/2 0/0 01 20p0,21p1,22P1,30O1,31O1":                     "SEED 22 23",
+	"0 20 2/2 0/0 01 21O1,23P1,29p0,30p1,32O1":                     "SEED 23 24",
+	"0 20 2/2 0/0 01 21O1,26O1,29p0,30p1,35P1":                     "SEED 35 17",
+	"0 20 2/2 0/0 01 21P1,25p1,26p0,30O1,34O1":                     "SEED 21 22",
+	"0 20 2/2 0/0 01 22O1,24p1,25p0,31P1,33O1":                     "SEED 31 30",
+	"0 20 2/2 0/0 01 22p1,23p0,24P1,31O1,33O1":                     "SEED 24 25",
+	"0 20 2/2 0/0 01 23O1,27O1,31p0,32p1,36P1":                     "SEED 36 18",
+	"0 20 2/2 0/0 01 23O1,27O1,32P1,35p0,36p1":                     "WAIT",
+	"0 20 2/2 0/0 01 24P1,26O1,33O1,34p0,35p1":                     "SEED 24 23",
+	"0 20 2/2 0/0 01 24p0,25p1,27P1,34O1,36O1":                     "SEED 27 12",
+	"0 20 2/2 0/0 01 24p1,25p0,27O1,33O1,36P1":                     "WAIT",
+	"0 20 2/2 0/0 01 25O1,26O1,34P1,35p1,36p0":                     "WAIT",
+	"0 20 2/2 0/0 01 25O1,26p1,27p0,34P1,35O1":                     "WAIT",
+	"0 20 2/2 0/0 01 25O1,27P1,33p0,34p1,36O1":                     "WAIT",
+	"0 20 2/2 0/0 01 25P1,27p1,28p0,34O1,36O1":                     "WAIT",
+	"0 20 2/2 0/0 01 25p0,26p1,27P1,35O1,36O1":                     "WAIT",
+	"0 20 2/2 0/0 01 7p0,19O1,27O1,28P1,36p1":                      "SEED 28 13",
+	"0 20 2/2 0/0 01 7p0,20p1,23O1,29O1,32P1":                      "SEED 32 33",
+	"0 20 2/2 0/0 01 8p0,21p1,22O1,30O1,31P1":                      "WAIT",
+	"0 20 2/2 0/0 01 8p0,21p1,25P1,30O1,34O1":                      "SEED 25 11",
+	"0 20 2/2 0/0 01 9p0,22p1,24P1,31O1,33O1":                      "SEED 24 25",
+	"1 20 0/0 0/0 00 10o0,16p0,23o1,24O1,25o1,32p1,33P1,34p1":      "WAIT",
+	"1 20 0/0 0/0 00 10p0,16o0,23p1,24P1,25p1,32o1,33O1,34o1":      "WAIT",
+	"1 20 0/0 0/0 00 12O0,26O1,27o2,35p2,36P1":                     "SEED 36 18",
+	"1 20 0/0 0/0 00 12P0,26P1,27p2,35o2,36O1":                     "WAIT",
+	"1 20 0/0 0/0 00 13o0,19p1,20p0,27o1,28o2,36p2":                "WAIT",
+	"1 20 0/0 0/0 00 13p0,19o1,20o0,27p1,28p2,36o2":                "WAIT",
+	"1 20 0/0 0/0 00 14O0,20P0,21p2,24P1,25P0,30O1,33o2,34O0":      "WAIT",
+	"1 20 0/0 0/0 00 14P0,20O0,21o2,24O1,25O0,30P1,33p2,34P0":      "WAIT",
+	"1 20 0/0 0/0 00 15O0,22P0,23P1,25O1,26O0,32o2,34P1,35p1":      "WAIT",
+	"1 20 0/0 0/0 00 15P0,22O0,23O1,25P1,26P0,32p2,34O1,35o1":      "WAIT",
+	"1 20 0/0 0/0 00 16O0,19P1,23P1,24p1,27O0,28O1,32o2,36P0":      "WAIT",
+	"1 20 0/0 0/0 00 16P0,19O1,23O1,24o1,27P0,28P1,32p2,36O0":      "WAIT",
+	"1 20 0/0 0/0 00 19O1,20O0,21o2,28P1,29P0,30p2,31P0,36O0":      "WAIT",
+	"1 20 0/0 0/0 00 19O1,27P1,28p2,36o2":                          "SEED 27 13",
+	"1 20 0/0 0/0 00 19P1,20P0,21p2,28O1,29O0,30o2,31O0,36P0":      "WAIT",
+	"1 20 0/0 0/0 00 19P1,27O1,28o2,36p2":                          "SEED 19 20",
+	"1 20 0/0 0/0 00 20O0,21O1,23O1,24o1,29P0,30p2,32P1,33P0":      "WAIT",
+	"1 20 0/0 0/0 00 20O0,21o2,24O1,25O0,29p1,30P1,32P0,33P1":      "WAIT",
+	"1 20 0/0 0/0 00 20P0,21P1,23P1,24p1,29O0,30o2,32O1,33O0":      "WAIT",
+	"1 20 0/0 0/0 00 20P0,21p2,24P1,25P0,29o1,30O1,32O0,33O1":      "WAIT",
+	"1 20 0/0 0/0 00 21O0,22o2,27O1,28O0,31P1,32p1,35P0,36P1":      "WAIT",
+	"1 20 0/0 0/0 00 21P0,22p2,27P1,28P0,31O1,32o1,35O0,36O1":      "WAIT",
+	"1 20 0/0 0/0 00 21o1,22O1,26P1,27P0,30p1,31P1,34O0,35O1":      "WAIT",
+	"1 20 0/0 0/0 00 21p1,22P1,26O1,27O0,30o1,31O1,34P0,35P1":      "WAIT",
+	"1 20 0/0 0/0 00 22o2,23O1,31p2,32P1":                          "SEED 32 15",
+	"1 20 0/0 0/0 00 22p2,23P1,31o2,32O1":                          "WAIT",
+	"1 20 0/0 0/0 00 23O1,24O1,25o1,32P1,33P1,34p1":                "SEED 32 16",
+	"1 20 0/0 0/0 00 23P1,24P1,25p1,32O1,33O1,34o1":                "SEED 23 10",
+	"1 20 0/0 0/0 00 25O0,26O1,27o2,35p2,36P1":                     "SEED 36 19",
+	"1 20 0/0 0/0 00 25P0,26P1,27p2,35o2,36O1":                     "WAIT",
+	"1 20 0/0 0/0 00 26O1,27o2,35P1,36p2":                          "WAIT",
+	"1 20 0/0 0/0 00 26P1,27p2,35O1,36o2":                          "SEED 26 12",
+	"1 20 0/0 0/0 00 7O0,12P0,13P0,18O0,19O1,26P1,28p2,35o2":       "WAIT",
+	"1 20 0/0 0/0 00 7P0,12O0,13O0,18P0,19P1,26O1,28o2,35p2":       "WAIT",
+	"1 20 0/0 0/0 00 7o0,13p0,19o1,27p1,28p2,36o2":                 "WAIT",
+	"1 20 0/0 0/0 00 7p0,13o0,19p1,27o1,28o2,36p2":                 "WAIT",
+	"1 20 0/0 0/0 00 8O0,21O1,25p2,26P0,29P0,30P1,34o2,35O0":       "WAIT",
+	"1 20 0/0 0/0 00 8P0,21P1,25o2,26O0,29O0,30O1,34p2,35P0":       "WAIT",
+	"1 20 0/0 0/0 00 9O0,15P0,21O1,24P0,25P1,30p2,34O1,35o1":       "WAIT",
+	"1 20 0/0 0/0 00 9O0,15P0,21O1,25p2,26P0,30P1,34O1,35o1":       "WAIT",
+	"1 20 0/0 0/0 00 9O0,15P0,23o2,25P1,26p1,32P1,34O1,35O0":       "WAIT",
+	"1 20 0/0 0/0 00 9P0,15O0,21P1,24O0,25O1,30o2,34P1,35p1":       "WAIT",
+	"1 20 0/0 0/0 00 9P0,15O0,21P1,25o2,26O0,30O1,34P1,35p1":       "WAIT",
+	"1 20 0/0 0/0 00 9P0,15O0,23p2,25O1,26o1,32O1,34P1,35P0":       "WAIT",
+	"1 20 0/0 0/0 00 9o0,23o1,24O1,25o1,31p0,32p1,33P1,34p1":       "WAIT",
+	"1 20 0/0 0/0 00 9p0,23p1,24P1,25p1,31o0,32o1,33O1,34o1":       "WAIT",
+	"1 20 0/0 0/0 01 10p0,17O0,24p1,25p1,26p0,27p1,33O1,35o1,36O1": "WAIT",
+	"1 20 0/0 0/0 01 11O0,17p0,24O1,25o2,33p1,34p2":                "WAIT",
+	"1 20 0/0 0/0 01 11O0,22o2,23O0,25O1,31p1,32p0,33P0,34p2":      "WAIT",
+	"1 20 0/0 0/0 01 11p0,13p0,17O0,19O0,24p1,27p1,28p1,33o2,36O1": "WAIT",
+	"1 20 0/0 0/0 01 12O0,15p0,22O1,23O0,26o2,31p1,32P0,35p2":      "WAIT",
+	"1 20 0/0 0/0 01 12O0,18p0,26O1,27o2,35p2,36p1":                "WAIT",
+	"1 20 0/0 0/0 01 12p0,26p1,27p2,35O1,36o2":                     "WAIT",
+	"1 20 0/0 0/0 01 15p0,22o2,23O1,31p2,32p1":                     "WAIT",
+	"1 20 0/0 0/0 01 17p0,19O0,20o2,25O1,26O0,28P0,29p2,34p1":      "WAIT",
+	"1 20 0/0 0/0 01 19p0,25O0,26O1,27o2,35p2,36p1":                "WAIT",
+	"1 20 0/0 0/0 01 20O1,21O0,22O0,23o2,29p2,30P0,31p0,32p1":      "WAIT",
+	"1 20 0/0 0/0 01 24p0,25p1,26p2,34O1,35O1,36o1":                "WAIT",
+	"1 20 0/0 0/0 01 7O0,11p0,13p1,14p0,17O0,20O1,26p1,29p1,35o2":  "WAIT",
+	"1 20 0/0 0/0 01 7O0,13p1,20O1,25p0,26p1,29p1,30p0,34o1,35O1":  "WAIT",
+	"1 20 0/0 0/0 01 7O0,9p0,13p0,19o2,22p1,23p1,28p1,32O1,33O0":   "WAIT",
+	"1 20 0/0 0/0 01 7p0,25O0,26O1,27o2,35p2,36p1":                 "WAIT",
+	"1 20 0/0 0/0 01 8p0,14O0,17p0,20p1,26O1,27O0,29o2,35p1,36p1":  "WAIT",
+	"1 20 0/0 0/0 01 9p0,19p1,22p1,23p1,27O0,28O1,31o1,32O1,36p0":  "WAIT",
+	"1 20 0/1 0/0 00 10P0,22p2,23p0,24p1,31o2,33o1,34o0":           "WAIT",
+	"1 20 0/1 0/0 00 11P0,19o2,24P1,27p1,28P1,33O1":                "WAIT",
+	"1 20 0/1 0/0 00 11P0,22p2,23P0,25P1,31O1,33O0,34o2":           "WAIT",
+	"1 20 0/1 0/0 00 11p1,17O0,19P1,20P0,26P1,28O1,35o2":           "WAIT",
+	"1 20 0/1 0/0 00 12P0,20O1,27p2,29P1,30P0,36o2":                "WAIT",
+	"1 20 0/1 0/0 00 12P0,22P1,23P0,26p2,31O1,32O0,35o2":           "WAIT",
+	"1 20 0/1 0/0 00 12o0,14p0,18P0,21o2,26o1,30p1,35p2":           "WAIT",
+	"1 20 0/1 0/0 00 12p1,24P0,25P1,27P1,34o2,36O1":                "WAIT",
+	"1 20 0/1 0/0 00 13P0,20O1,21o2,29p2,30P1,31P0":                "WAIT",
+	"1 20 0/1 0/0 00 13P0,22O1,27P1,30P0,31p2,36o2":                "WAIT",
+	"1 20 0/1 0/0 00 13p0,25o2,27p1,33P0,34p2,35o0,36o1":           "WAIT",
+	"1 20 0/1 0/0 00 14O0,20P0,21P1,23p1,24P1,30o2,33O1":           "WAIT",
+	"1 20 0/1 0/0 00 14P0,20O1,26P1,27P0,29p2,35O1,36o1":           "WAIT",
+	"1 20 0/1 0/0 00 14P0,21O1,24o2,30p2,33P1,34P0":                "WAIT",
+	"1 20 0/1 0/0 00 15P0,19o1,23O1,27P1,28p1,32P1,36O1":           "WAIT",
+	"1 20 0/1 0/0 00 15P0,19p2,20P0,23O1,28o2,32P1":                "WAIT",
+	"1 20 0/1 0/0 00 15P0,21o2,27p2,28P0,30P1,36O1":                "WAIT",
+	"1 20 0/1 0/0 00 17O0,19P1,20P0,23p1,24P1,28O1,33o2":           "WAIT",
+	"1 20 0/1 0/0 00 17O0,23P0,24p2,26P0,27P1,33o2,36O1":           "WAIT",
+	"1 20 0/1 0/0 00 17P0,19P0,24O1,27O1,28o1,33p2,36P1":           "WAIT",
+	"1 20 0/1 0/0 00 17P0,24O1,25o1,27O1,33P1,35p1,36P1":           "WAIT",
+	"1 20 0/1 0/0 00 17p1,21o2,26O1,29P0,30P1,35P1":                "WAIT",
+	"1 20 0/1 0/0 00 18P0,23o2,26O1,32P1,33P0,35p2":                "WAIT",
+	"1 20 0/1 0/0 00 18P0,23o2,27O1,31P0,32P1,36p2":                "WAIT",
+	"1 20 0/1 0/0 00 19O1,22o1,23O1,27P0,28P1,31p1,32P1":           "WAIT",
+	"1 20 0/1 0/0 00 19O1,24P0,25p2,28P1,29P0,33O0,34o2":           "WAIT",
+	"1 20 0/1 0/0 00 19P0,20p2,25P1,26P0,28O0,29o2,34O1":           "WAIT",
+	"1 20 0/1 0/0 00 19P1,20P0,22p2,23P0,28o2,31O1":                "WAIT",
+	"1 20 0/1 0/0 00 19P1,24P0,25P1,28o2,34O1,36p1":                "WAIT",
+	"1 20 0/1 0/0 00 19o1,25O1,27P1,28p1,33P0,34P1,36O1":           "WAIT",
+	"1 20 0/1 0/0 00 19p1,20P1,22O1,29o2,31P1,32P0":                "WAIT",
+	"1 20 0/1 0/0 00 20P0,21P1,22P0,23p2,30o2,31O0,32O1":           "WAIT",
+	"1 20 0/1 0/0 00 20P0,21P1,22p2,23P0,30O1,31o2":                "WAIT",
+	"1 20 0/1 0/0 00 20P1,21P0,22P0,23p2,29o2,30O0,32O1":           "WAIT",
+	"1 20 0/1 0/0 00 20P1,21P0,23p1,24P1,29O1,30o1,33O1":           "WAIT",
+	"1 20 0/1 0/0 00 21O1,23p2,24P0,29P0,30P1,32o2":                "WAIT",
+	"1 20 0/1 0/0 00 21P1,22P0,25p2,26P0,30O1,34o2":                "WAIT",
+	"1 20 0/1 0/0 00 22O1,24P1,25P0,30P0,31p2,33o2":                "WAIT",
+	"1 20 0/1 0/0 00 22P0,23p2,25O1,26O0,32o2,34P1,35P0":           "WAIT",
+	"1 20 0/1 0/0 00 22P1,23P0,24p2,25P0,31o2,33O1":                "WAIT",
+	"1 20 0/1 0/0 00 22o1,23o0,24p2,25P0,31p1,32p0,33o2":           "WAIT",
+	"1 20 0/1 0/0 00 23P0,24P1,26O1,33o2,34p1,35P1":                "WAIT",
+	"1 20 0/1 0/0 00 7P0,13o1,17P0,20P1,26O1,29O1,35p2":            "WAIT",
+	"1 20 0/1 0/0 00 7P0,13o1,20P1,26O1,29O1,34p1,35P1":            "WAIT",
+	"1 20 0/1 0/0 00 7P0,17p1,24o2,27O1,33P1,36P1":                 "WAIT",
+	"1 20 0/1 0/0 00 7P0,19P1,25O1,28o2,29O0,34P1,35p1":            "WAIT",
+	"1 20 0/1 0/0 00 7P0,19p2,22O1,28o2,31P1,32P0":                 "WAIT",
+	"1 20 0/1 0/0 00 7P0,19p2,22o1,23O1,28O1,32P1,33P0":            "WAIT",
+	"1 20 0/1 0/0 00 7P0,20P1,23o2,29O1,31P0,32p2":                 "WAIT",
+	"1 20 0/1 0/0 00 7P0,20P1,23o2,29O1,32P1,33p1":                 "WAIT",
+	"1 20 0/1 0/0 00 7o0,13P0,20o1,26o2,29p2,34p0,35p1":            "WAIT",
+	"1 20 0/1 0/0 00 8P0,10P0,20p2,24P1,29o2,33O1":                 "WAIT",
+	"1 20 0/1 0/0 00 8P0,21p2,23P1,24P0,30O1,32O1,33o1":            "WAIT",
+	"1 20 0/1 0/0 00 9P0,12P0,15o1,22p2,26P1,31O1,35O1":            "WAIT",
+	"1 20 0/1 0/0 00 9P0,22P1,24P1,25p1,31o2,33O1":                 "WAIT",
+	"1 20 0/1 0/0 01 10p0,19o1,20o0,23p1,27p1,28p1,29p0,32O1,36o1": "WAIT",
+	"1 20 0/1 0/0 01 10p0,19o2,24p1,27p1,28p1,29p0,33o1,34o0":      "WAIT",
+	"1 20 0/1 0/0 01 13p0,19O1,20o2,28p2,29p1,30P0":                "WAIT",
+	"1 20 0/1 0/0 01 13p0,20O1,24p0,25p1,26p1,29p1,33O0,34o2":      "WAIT",
+	"1 20 0/1 0/0 01 15p0,19p0,23o2,26o0,27o1,32p1,35p1,36p1":      "WAIT",
+	"1 20 0/1 0/0 01 19O0,25O0,26O1,27p2,28P0,34P0,35P1,36O1":      "WAIT",
+	"1 20 0/1 0/0 01 19p0,20p1,25O1,29o2,30O0,33P0,34p2":           "WAIT",
+	"1 20 0/1 0/0 01 19p1,24p0,25p1,26p1,28o2,34O1,36p0":           "WAIT",
+	"1 20 0/1 0/0 01 19p2,25o2,28O1,33P0,34p1,35p0":                "WAIT",
+	"1 20 0/1 0/0 01 21P0,22p1,23p0,26o2,30O0,31O1,35p2":           "WAIT",
+	"1 20 0/1 0/0 01 21p0,22p1,23P0,25o2,31O1,32O0,34p2":           "WAIT",
+	"1 20 0/1 0/0 01 7p0,17p0,19p1,25o1,26O1,28O1,34p1,35p1":       "WAIT",
+	"1 20 0/1 0/0 01 8O0,14p0,21o2,22p2,23P0,30p1,31O1":            "WAIT",
+	"1 20 0/1 0/0 01 8O0,14p0,21o2,22p2,29P0,30p1,31O1":            "WAIT",
+	"1 20 0/1 0/0 01 9O0,11p0,15P0,22O1,25p1,31p2,34o2":            "WAIT",
+	"1 20 0/1 0/0 01 9o0,15p0,19O1,23o1,24o1,27p0,28p1,32p1,33p1":  "WAIT",
+	"1 20 0/2 0/0 00 19O1,26o0,27o1,28P1,29P0,35P0,36p2":           "WAIT",
+	"1 20 0/2 0/0 01 11P0,23O1,24p2,31P0,32P1,33O1":                "WAIT",
+	"1 20 0/2 0/0 01 18P0,19O1,27O1,28p2,29P0,36P1":                "WAIT",
+	"1 20 0/2 0/0 01 7P0,13P0,19O1,27O1,28p2,36P1":                 "WAIT",
+	"1 20 0/3 0/0 00 11P0,17o0,24P1,25p2,33o1,34O1":                "WAIT",
+	"1 20 0/3 0/0 00 24o0,25o1,26O1,34P1,35P1,36p1":                "WAIT",
+	"1 20 0/3 0/0 00 8o0,14p0,20o1,21o1,22o0,29p1,30p2,31P0":       "WAIT",
+	"1 20 0/4 0/0 00 18P0,21o1,22o0,26O1,29P0,30P1,35p2":           "WAIT",
+	"1 20 0/4 0/0 00 19o0,20o1,21O1,28P0,29p2,30P1,31P0":           "WAIT",
+	"1 20 0/4 0/0 00 20O1,25o0,26o1,29p2,30P0,34P0,35P1":           "WAIT",
+	"1 20 0/4 0/0 00 7o0,13P0,20o1,21P1,22P0,29p2,30O1":            "WAIT",
+	"1 20 0/4 0/0 00 7o0,14P0,20o1,26P0,27p2,29P1,36O1":            "WAIT",
+	"1 20 0/4 0/0 00 8P0,11P0,14o0,21P1,25p2,30o1,34O1":            "WAIT",
+	"1 20 0/4 0/0 01 9P0,19O0,20O1,21p2,28P0,29P1,30O1":            "WAIT",
+	"1 20 1/0 0/0 00 10O0,22o2,23o0,24o1,31p2,33p1,34p0":           "WAIT",
+	"1 20 1/0 0/0 00 11O0,19p2,24O1,27o1,28O1,33P1":                "WAIT",
+	"1 20 1/0 0/0 00 11O0,22o2,23O0,25O1,31P1,33P0,34p2":           "SEED 31 32",
+	"1 20 1/0 0/0 00 11o1,17P0,19O1,20O0,26O1,28P1,35p2":           "WAIT",
+	"1 20 1/0 0/0 00 12O0,20P1,27o2,29O1,30O0,36p2":                "SEED 20 21",
+	"1 20 1/0 0/0 00 12O0,22O1,23O0,26o2,31P1,32P0,35p2":           "SEED 31 15",
+	"1 20 1/0 0/0 00 12o1,24O0,25O1,27O1,34p2,36P1":                "SEED 36 18",
+	"1 20 1/0 0/0 00 12p0,14o0,18O0,21p2,26p1,30o1,35o2":           "WAIT",
+	"1 20 1/0 0/0 00 13O0,20P1,21p2,29o2,30O1,31O0":                "WAIT",
+	"1 20 1/0 0/0 00 13O0,22P1,27O1,30O0,31o2,36p2":                "WAIT",
+	"1 20 1/0 0/0 00 13o0,25p2,27o1,33O0,34o2,35p0,36p1":           "WAIT",
+	"1 20 1/0 0/0 00 14O0,20P1,26O1,27O0,29o2,35P1,36p1":           "SEED 35 17",
+	"1 20 1/0 0/0 00 14O0,21P1,24p2,30o2,33O1,34O0":                "SEED 21 8",
+	"1 20 1/0 0/0 00 14P0,20O0,21O1,23o1,24O1,30p2,33P1":           "WAIT",
+	"1 20 1/0 0/0 00 15O0,19o2,20O0,23P1,28p2,32O1":                "SEED 23 9",
+	"1 20 1/0 0/0 00 15O0,19p1,23P1,27O1,28o1,32O1,36P1":           "SEED 36 35",
+	"1 20 1/0 0/0 00 15O0,21p2,27o2,28O0,30O1,36P1":                "SEED 36 35",
+	"1 20 1/0 0/0 00 17O0,19O0,24P1,27P1,28p1,33o2,36O1":           "SEED 27 13",
+	"1 20 1/0 0/0 00 17O0,24P1,25p1,27P1,33O1,35o1,36O1":           "SEED 24 10",
+	"1 20 1/0 0/0 00 17P0,19O1,20O0,23o1,24O1,28P1,33p2":           "WAIT",
+	"1 20 1/0 0/0 00 17P0,23O0,24o2,26O0,27O1,33p2,36P1":           "WAIT",
+	"1 20 1/0 0/0 00 17o1,21p2,26P1,29O0,30O1,35O1":                "SEED 26 25",
+	"1 20 1/0 0/0 00 18O0,23p2,26P1,32O1,33O0,35o2":                "SEED 26 12",
+	"1 20 1/0 0/0 00 18O0,23p2,27P1,31O0,32O1,36o2":                "SEED 27 28",
+	"1 20 1/0 0/0 00 19O0,20o2,25O1,26O0,28P0,29p2,34P1":           "SEED 34 17",
+	"1 20 1/0 0/0 00 19O1,20O0,22o2,23O0,28p2,31P1":                "WAIT",
+	"1 20 1/0 0/0 00 19O1,24O0,25O1,28p2,34P1,36o1":                "SEED 34 17",
+	"1 20 1/0 0/0 00 19P1,22p1,23P1,27O0,28O1,31o1,32O1":           "SEED 23 9",
+	"1 20 1/0 0/0 00 19P1,24O0,25o2,28O1,29O0,33P0,34p2":           "WAIT",
+	"1 20 1/0 0/0 00 19o1,20O1,22P1,29p2,31O1,32O0":                "SEED 22 23",
+	"1 20 1/0 0/0 00 19p1,25P1,27O1,28o1,33O0,34O1,36P1":           "SEED 25 24",
+	"1 20 1/0 0/0 00 20O0,21O1,22O0,23o2,30p2,31P0,32P1":           "WAIT",
+	"1 20 1/0 0/0 00 20O0,21O1,22o2,23O0,30P1,31p2":                "WAIT",
+	"1 20 1/0 0/0 00 20O1,21O0,22O0,23o2,29p2,30P0,32P1":           "SEED 32 31",
+	"1 20 1/0 0/0 00 20O1,21O0,23o1,24O1,29P1,30p1,33P1":           "SEED 33 17",
+	"1 20 1/0 0/0 00 21O1,22O0,25o2,26O0,30P1,34p2":                "SEED 30 31",
+	"1 20 1/0 0/0 00 21P1,23o2,24O0,29O0,30O1,32p2":                "SEED 21 20",
+	"1 20 1/0 0/0 00 22O0,23o2,25P1,26P0,32p2,34O1,35O0":           "WAIT",
+	"1 20 1/0 0/0 00 22O1,23O0,24o2,25O0,31p2,33P1":                "SEED 33 32",
+	"1 20 1/0 0/0 00 22P1,24O1,25O0,30O0,31o2,33p2":                "WAIT",
+	"1 20 1/0 0/0 00 22p1,23p0,24o2,25O0,31o1,32o0,33p2":           "WAIT",
+	"1 20 1/0 0/0 00 23O0,24O1,26P1,33p2,34o1,35O1":                "SEED 26 27",
+	"1 20 1/0 0/0 00 7O0,13p1,17O0,20O1,26P1,29P1,35o2":            "SEED 26 11",
+	"1 20 1/0 0/0 00 7O0,13p1,20O1,26P1,29P1,34o1,35O1":            "SEED 26 25",
+	"1 20 1/0 0/0 00 7O0,17o1,24p2,27P1,33O1,36O1":                 "SEED 27 13",
+	"1 20 1/0 0/0 00 7O0,19O1,25P1,28p2,29P0,34O1,35o1":            "WAIT",
+	"1 20 1/0 0/0 00 7O0,19o2,22P1,28p2,31O1,32O0":                 "SEED 22 23",
+	"1 20 1/0 0/0 00 7O0,19o2,22p1,23P1,28P1,32O1,33O0":            "SEED 23 9",
+	"1 20 1/0 0/0 00 7O0,20O1,23p2,29P1,31O0,32o2":                 "SEED 29 28",
+	"1 20 1/0 0/0 00 7O0,20O1,23p2,29P1,32O1,33o1":                 "SEED 29 28",
+	"1 20 1/0 0/0 00 7p0,13O0,20p1,26p2,29o2,34o0,35o1":            "WAIT",
+	"1 20 1/0 0/0 00 8O0,10O0,20o2,24O1,29p2,33P1":                 "SEED 33 16",
+	"1 20 1/0 0/0 00 8O0,21o2,23O1,24O0,30P1,32P1,33p1":            "WAIT",
+	"1 20 1/0 0/0 00 9O0,12O0,15p1,22o2,26O1,31P1,35P1":            "WAIT",
+	"1 20 1/0 0/0 00 9O0,22O1,24O1,25o1,31p2,33P1":                 "SEED 33 34",
+	"1 20 1/0 0/0 01 10p0,17O0,24p1,25p1,27P1,33O1,35o1,36O1":      "SEED 27 26",
+	"1 20 1/0 0/0 01 11p0,17o1,21p2,26p1,29O0,30O1,35O1":           "WAIT",
+	"1 20 1/0 0/0 01 12O0,20p1,21p0,27o2,29O1,30O0,36p2":           "WAIT",
+	"1 20 1/0 0/0 01 12o1,18p0,24O0,25O1,27O1,34p2,36p1":           "WAIT",
+	"1 20 1/0 0/0 01 12p0,18O0,23p2,26p1,32O1,33O0,35o2":           "WAIT",
+	"1 20 1/0 0/0 01 13p0,17O0,19O0,24P1,27p1,28p1,33o2,36O1":      "SEED 24 11",
+	"1 20 1/0 0/0 01 14O0,17p0,20P1,26O1,27O0,29o2,35p1,36p1":      "SEED 20 8",
+	"1 20 1/0 0/0 01 15O0,19p1,23P1,27O1,28o1,32O1,35p0,36p1":      "WAIT",
+	"1 20 1/0 0/0 01 15O0,21p2,27o2,28O0,30O1,35p0,36p1":           "WAIT",
+	"1 20 1/0 0/0 01 17o1,21p2,25p0,26p1,29O0,30O1,35O1":           "WAIT",
+	"1 20 1/0 0/0 01 17o1,21p2,26p1,27p0,29O0,30O1,35O1":           "WAIT",
+	"1 20 1/0 0/0 01 17p0,19O1,24O0,25O1,28p2,34p1,36o1":           "WAIT",
+	"1 20 1/0 0/0 01 17p0,20O1,21O0,23o1,24O1,29P1,30p1,33p1":      "WAIT",
+	"1 20 1/0 0/0 01 18O0,21p1,22p0,26p2,29O0,30O1,35o2":           "WAIT",
+	"1 20 1/0 0/0 01 18O0,23p2,27p1,28p0,31O0,32O1,36o2":           "WAIT",
+	"1 20 1/0 0/0 01 19o1,20O1,22p1,23p0,29p2,31O1,32O0":           "WAIT",
+	"1 20 1/0 0/0 01 19p0,20p1,21p2,28O0,29o2,30O1,31O0":           "WAIT",
+	"1 20 1/0 0/0 01 19p1,20p0,26p0,27p1,28O1,29O0,35O0,36o2":      "WAIT",
+	"1 20 1/0 0/0 01 19p1,24p0,25p1,27O1,28o1,33O0,34O1,36P1":      "WAIT",
+	"1 20 1/0 0/0 01 20p0,21p1,23o2,24O0,29O0,30O1,32p2":           "WAIT",
+	"1 20 1/0 0/0 01 20p2,25p0,26p1,29o2,30O0,34O0,35O1":           "WAIT",
+	"1 20 1/0 0/0 01 21O1,22O0,25o2,26O0,30p1,31p0,34p2":           "WAIT",
+	"1 20 1/0 0/0 01 22O1,23O0,24o2,25O0,31p2,32p0,33p1":           "WAIT",
+	"1 20 1/0 0/0 01 23O0,24O1,26p1,27p0,33p2,34o1,35O1":           "WAIT",
+	"1 20 1/0 0/0 01 7O0,11p0,13p1,17O0,20O1,26p1,29P1,35o2":       "SEED 29 14",
+	"1 20 1/0 0/0 01 7O0,13p0,17o1,24p2,27p1,33O1,36O1":            "WAIT",
+	"1 20 1/0 0/0 01 7O0,13p1,20O1,25p0,26p1,29P1,34o1,35O1":       "SEED 29 30",
+	"1 20 1/0 0/0 01 7O0,19o2,22p1,23p0,28p2,31O1,32O0":            "WAIT",
+	"1 20 1/0 0/0 01 7O0,20O1,23p2,28p0,29p1,31O0,32o2":            "WAIT",
+	"1 20 1/0 0/0 01 7O0,20O1,23p2,28p0,29p1,32O1,33o1":            "WAIT",
+	"1 20 1/0 0/0 01 7O0,9p0,19o2,22p1,23p1,28P1,32O1,33O0":        "SEED 28 13",
+	"1 20 1/0 0/0 01 7p0,13O0,20p1,21O1,22O0,29o2,30p2":            "WAIT",
+	"1 20 1/0 0/0 01 8O0,10O0,16p0,20o2,24O1,29p2,33p1":            "WAIT",
+	"1 20 1/0 0/0 01 8O0,11O0,14p0,21O1,25o2,30p1,34p2":            "WAIT",
+	"1 20 1/0 0/0 01 8p0,14O0,21p1,24p2,30o2,33O1,34O0":            "WAIT",
+	"1 20 1/0 0/0 01 9O0,22O1,24O1,25o1,31p2,33p1,34p0":            "WAIT",
+	"1 20 1/0 0/0 01 9p0,15O0,19o2,20O0,23p1,28p2,32O1":            "WAIT",
+	"1 20 1/0 0/0 01 9p0,19P1,22p1,23p1,27O0,28O1,31o1,32O1":       "SEED 19 36",
+	"1 20 1/1 0/0 00 10O0,22o2,24O1,31p2,33P1":                     "SEED 33 34",
+	"1 20 1/1 0/0 00 10P0,22p2,24P1,31o2,33O1":                     "SEED 24 23",
+	"1 20 1/1 0/0 00 10o0,19p1,20p0,23o1,27o1,28O1,32P1,36p1":      "WAIT",
+	"1 20 1/1 0/0 00 10p0,19o1,20o0,23p1,27p1,28P1,32O1,36o1":      "SEED 28 29",
+	"1 20 1/1 0/0 00 11O0,19o2,26O1,28P1,34p1,35P1":                "WAIT",
+	"1 20 1/1 0/0 00 11P0,19p2,26P1,28O1,34o1,35O1":                "WAIT",
+	"1 20 1/1 0/0 00 11o0,17p0,23p2,26o1,32o2,35p1":                "WAIT",
+	"1 20 1/1 0/0 00 11p0,17o0,23o2,26p1,32p2,35o1":                "WAIT",
+	"1 20 1/1 0/0 00 13O0,20P1,26p2,29o2,35O1":                     "SEED 20 7",
+	"1 20 1/1 0/0 00 13P0,20O1,26o2,29p2,35P1":                     "SEED 35 34",
+	"1 20 1/1 0/0 00 14O0,20P1,24O1,29o2,33p2":                     "SEED 20 19",
+	"1 20 1/1 0/0 00 14O0,21P1,24p2,30o2,33O1":                     "SEED 21 8",
+	"1 20 1/1 0/0 00 14P0,20O1,24P1,29p2,33o2":                     "WAIT",
+	"1 20 1/1 0/0 00 14P0,21O1,24o2,30p2,33P1":                     "WAIT",
+	"1 20 1/1 0/0 00 15o0,19p0,20p1,22p2,29o2,31o1":                "WAIT",
+	"1 20 1/1 0/0 00 15o0,20p1,21p0,22p2,29o2,31o1":                "WAIT",
+	"1 20 1/1 0/0 00 15p0,19o0,20o1,22o2,29p2,31p1":                "WAIT",
+	"1 20 1/1 0/0 00 15p0,20o1,21o0,22o2,29p2,31p1":                "WAIT",
+	"1 20 1/1 0/0 00 17o0,19O1,25p1,26P1,28p1,29p0,34o1,35o1":      "WAIT",
+	"1 20 1/1 0/0 00 17p0,19P1,25o1,26O1,28o1,29o0,34p1,35p1":      "WAIT",
+	"1 20 1/1 0/0 00 19O0,20o2,21P1,28P0,29p2,30O1":                "WAIT",
+	"1 20 1/1 0/0 00 19O0,20o2,22P1,29p2,31O1":                     "SEED 22 21",
+	"1 20 1/1 0/0 00 19O1,20o2,28p2,29P1,30P0":                     "SEED 29 13",
+	"1 20 1/1 0/0 00 19O1,21o2,28P1,30P1,31p1":                     "SEED 30 15",
+	"1 20 1/1 0/0 00 19O1,23O1,24o1,28P1,32P1,33p1":                "SEED 32 15",
+	"1 20 1/1 0/0 00 19O1,23P1,27p1,28P1,32O1,36o1":                "SEED 23 10",
+	"1 20 1/1 0/0 00 19O1,25O1,26o1,28p2,34P1":                     "WAIT",
+	"1 20 1/1 0/0 00 19O1,25p1,26P1,28P1,34o1,35O1":                "SEED 28 29",
+	"1 20 1/1 0/0 00 19P0,20p2,21O1,28O0,29o2,30P1":                "WAIT",
+	"1 20 1/1 0/0 00 19P0,20p2,22O1,29o2,31P1":                     "WAIT",
+	"1 20 1/1 0/0 00 19P1,20p2,28o2,29O1,30O0":                     "WAIT",
+	"1 20 1/1 0/0 00 19P1,21p2,28O1,30O1,31o1":                     "WAIT",
+	"1 20 1/1 0/0 00 19P1,23O1,27o1,28O1,32P1,36p1":                "SEED 19 20",
+	"1 20 1/1 0/0 00 19P1,23P1,24p1,28O1,32O1,33o1":                "SEED 23 9",
+	"1 20 1/1 0/0 00 19P1,25P1,26p1,28o2,34O1":                     "SEED 25 24",
+	"1 20 1/1 0/0 00 19P1,25o1,26O1,28O1,34p1,35P1":                "SEED 35 17",
+	"1 20 1/1 0/0 00 19o0,20o1,23o2,28p0,29p1,32p2":                "WAIT",
+	"1 20 1/1 0/0 00 19o0,23p2,26p0,27p1,32O1,35o1,36o1":           "WAIT",
+	"1 20 1/1 0/0 00 19o1,20O1,25O1,28p1,29P1,34P1":                "WAIT",
+	"1 20 1/1 0/0 00 19o2,21P1,28p2,30O1":                          "WAIT",
+	"1 20 1/1 0/0 00 19o2,24P1,27p1,28P1,33O1":                     "SEED 28 29",
+	"1 20 1/1 0/0 00 19o2,24P1,27p1,28p1,29p0,33o1,34o0":           "SEED 24 10",
+	"1 20 1/1 0/0 00 19o2,25O1,28p2,34P1":                          "WAIT",
+	"1 20 1/1 0/0 00 19o2,25p2,28P1,33O0,34O1":                     "WAIT",
+	"1 20 1/1 0/0 00 19p0,20p1,23p2,28o0,29o1,32o2":                "WAIT",
+	"1 20 1/1 0/0 00 19p0,23o2,26o0,27o1,32P1,35p1,36p1":           "SEED 32 15",
+	"1 20 1/1 0/0 00 19p1,20P1,25P1,28o1,29O1,34O1":                "SEED 25 11",
+	"1 20 1/1 0/0 00 19p2,21O1,28o2,30P1":                          "WAIT",
+	"1 20 1/1 0/0 00 19p2,24O1,27o1,28O1,33P1":                     "SEED 33 34",
+	"1 20 1/1 0/0 00 19p2,24O1,27o1,28o1,29o0,33p1,34p0":           "WAIT",
+	"1 20 1/1 0/0 00 19p2,25P1,28o2,34O1":                          "WAIT",
+	"1 20 1/1 0/0 00 19p2,25o2,28O1,33P0,34P1":                     "SEED 34 35",
+	"1 20 1/1 0/0 00 20O1,22o2,29p2,31P1":                          "SEED 31 15",
+	"1 20 1/1 0/0 00 20O1,23o2,29P1,32p2":                          "SEED 29 28",
+	"1 20 1/1 0/0 00 20O1,25P1,26p1,29P1,33O0,34o2":                "SEED 25 24",
+	"1 20 1/1 0/0 00 20O1,25P1,29p2,30P0,33O0,34o2":                "WAIT",
+	"1 20 1/1 0/0 00 20P1,22p2,29o2,31O1":                          "SEED 20 19",
+	"1 20 1/1 0/0 00 20P1,23p2,29O1,32o2":                          "SEED 20 19",
+	"1 20 1/1 0/0 00 20P1,25O1,26o1,29O1,33P0,34p2":                "WAIT",
+	"1 20 1/1 0/0 00 20P1,25O1,29o2,30O0,33P0,34p2":                "SEED 20 19",
+	"1 20 1/1 0/0 00 20o2,27O1,29p2,36P1":                          "WAIT",
+	"1 20 1/1 0/0 00 20p2,27P1,29o2,36O1":                          "SEED 27 28",
+	"1 20 1/1 0/0 00 21O0,22O1,23p2,31P1,32o1,33o0":                "SEED 31 30",
+	"1 20 1/1 0/0 00 21O0,22O1,26p2,30P0,31P1,35o2":                "WAIT",
+	"1 20 1/1 0/0 00 21O0,22o2,25O1,30p1,31P1,34P1":                "SEED 34 35",
+	"1 20 1/1 0/0 00 21O1,24p2,30P1,33o2":                          "WAIT",
+	"1 20 1/1 0/0 00 21O1,26o2,30p2,35P1":                          "SEED 35 36",
+	"1 20 1/1 0/0 00 21O1,26p2,30P1,35o2":                          "SEED 30 14",
+	"1 20 1/1 0/0 00 21P0,22P1,23o2,31O1,32p1,33p0":                "WAIT",
+	"1 20 1/1 0/0 00 21P0,22P1,26o2,30O0,31O1,35p2":                "SEED 22 23",
+	"1 20 1/1 0/0 00 21P0,22p2,25P1,30o1,31O1,34O1":                "WAIT",
+	"1 20 1/1 0/0 00 21P1,24o2,30O1,33p2":                          "SEED 21 20",
+	"1 20 1/1 0/0 00 21P1,26o2,30O1,35p2":                          "SEED 21 8",
+	"1 20 1/1 0/0 00 21P1,26p2,30o2,35O1":                          "SEED 21 22",
+	"1 20 1/1 0/0 00 21o1,22o0,26o2,30p2,35p1,36p0":                "WAIT",
+	"1 20 1/1 0/0 00 21o1,22o0,26p2,29p0,30p1,35o2":                "WAIT",
+	"1 20 1/1 0/0 00 21o2,22O0,23O1,30P1,31p1,32P1":                "SEED 30 29",
+	"1 20 1/1 0/0 00 21o2,23P1,29p1,30P1,32O1":                     "WAIT",
+	"1 20 1/1 0/0 00 21p1,22p0,26o2,29o0,30o1,35p2":                "WAIT",
+	"1 20 1/1 0/0 00 21p1,22p0,26p2,30o2,35o1,36o0":                "WAIT",
+	"1 20 1/1 0/0 00 21p2,22P0,23P1,30O1,31o1,32O1":                "WAIT",
+	"1 20 1/1 0/0 00 21p2,23O1,29o1,30O1,32P1":                     "WAIT",
+	"1 20 1/1 0/0 00 22O1,23O0,25p2,31P1,32P0,34o2":                "WAIT",
+	"1 20 1/1 0/0 00 22P1,23P0,25o2,31O1,32O0,34p2":                "SEED 22 21",
+	"1 20 1/1 0/0 00 23o2,25p0,26p1,32p2,34o0,35o1":                "WAIT",
+	"1 20 1/1 0/0 00 23o2,26P1,32p2,35O1":                          "SEED 26 11",
+	"1 20 1/1 0/0 00 23p2,25o0,26o1,32o2,34p0,35p1":                "WAIT",
+	"1 20 1/1 0/0 00 23p2,26O1,32o2,35P1":                          "SEED 35 17",
+	"1 20 1/1 0/0 00 24O1,25o1,27P1,33p2,36O1":                     "SEED 27 28",
+	"1 20 1/1 0/0 00 24P1,25p1,27O1,33o2,36P1":                     "WAIT",
+	"1 20 1/1 0/0 00 24o2,25o0,26o1,32p0,33p1,35p2":                "WAIT",
+	"1 20 1/1 0/0 00 24o2,25o0,26o1,33p1,34p0,35p2":                "WAIT",
+	"1 20 1/1 0/0 00 24o2,26O1,33P1,35p2":                          "SEED 33 32",
+	"1 20 1/1 0/0 00 24o2,26o1,27o0,32p0,33p1,35p2":                "WAIT",
+	"1 20 1/1 0/0 00 24p2,25p0,26p1,32o0,33o1,35o2":                "WAIT",
+	"1 20 1/1 0/0 00 24p2,25p0,26p1,33o1,34o0,35o2":                "WAIT",
+	"1 20 1/1 0/0 00 24p2,26P1,33O1,35o2":                          "SEED 26 25",
+	"1 20 1/1 0/0 00 24p2,26p1,27p0,32o0,33o1,35o2":                "WAIT",
+	"1 20 1/1 0/0 00 25O1,27O1,28o1,34p2,36P1":                     "SEED 36 19",
+	"1 20 1/1 0/0 00 25O1,27o2,34P1,36p2":                          "SEED 34 17",
+	"1 20 1/1 0/0 00 25P1,27P1,28p1,34o2,36O1":                     "WAIT",
+	"1 20 1/1 0/0 00 25P1,27p2,34O1,36o2":                          "WAIT",
+	"1 20 1/1 0/0 00 25o2,27P1,33P0,34p2,36O1":                     "SEED 27 13",
+	"1 20 1/1 0/0 00 25p2,27O1,33O0,34o2,36P1":                     "SEED 36 35",
+	"1 20 1/1 0/0 00 7o1,13p1,20O1,26P1,29P1,35O1":                 "SEED 29 30",
+	"1 20 1/1 0/0 00 7o1,13p1,20o1,21o0,26P1,29p1,30p0,35O1":       "WAIT",
+	"1 20 1/1 0/0 00 7p1,13o1,20P1,26O1,29O1,35P1":                 "SEED 20 21",
+	"1 20 1/1 0/0 00 7p1,13o1,20p1,21p0,26O1,29o1,30o0,35P1":       "WAIT",
+	"1 20 1/1 0/0 00 8O0,21O1,22p2,30P1,31o2":                      "SEED 30 14",
+	"1 20 1/1 0/0 00 8O0,21o2,22p2,23P0,30P1,31O1":                 "SEED 30 14",
+	"1 20 1/1 0/0 00 8O0,21o2,22p2,29P0,30P1,31O1":                 "SEED 30 14",
+	"1 20 1/1 0/0 00 8P0,21P1,22o2,30O1,31p2":                      "WAIT",
+	"1 20 1/1 0/0 00 8P0,21p2,22o2,23O0,30O1,31P1":                 "WAIT",
+	"1 20 1/1 0/0 00 8P0,21p2,22o2,29O0,30O1,31P1":                 "WAIT",
+	"1 20 1/1 0/0 00 8o0,14p0,21o1,26p2,30p1,35o2":                 "WAIT",
+	"1 20 1/1 0/0 00 8p0,14o0,21p1,26o2,30o1,35p2":                 "WAIT",
+	"1 20 1/1 0/0 00 9O0,15P0,22O1,25P1,31p2,34o2":                 "SEED 25 11",
+	"1 20 1/1 0/0 00 9O0,15o0,21p2,23O1,29O0,30o1,32P1":            "WAIT",
+	"1 20 1/1 0/0 00 9P0,15O0,22P1,25O1,31o2,34p2":                 "WAIT",
+	"1 20 1/1 0/0 00 9P0,15p0,21o2,23P1,29P0,30p1,32O1":            "WAIT",
+	"1 20 1/1 0/0 00 9o0,15p0,19O1,23o1,24o1,28P1,32p1,33p1":       "SEED 28 27",
+	"1 20 1/1 0/0 00 9p0,15o0,19P1,23p1,24p1,28O1,32o1,33o1":       "WAIT",
+	"1 20 1/1 0/0 01 10p0,16o1,23p1,27p2,32O1,36O1":                "WAIT",
+	"1 20 1/1 0/0 01 11O0,17p0,19o2,25O1,28p2,34p1":                "WAIT",
+	"1 20 1/1 0/0 01 11p0,19p1,20P1,25p1,28o1,29O1,34O1":           "WAIT",
+	"1 20 1/1 0/0 01 14O0,19p0,20p1,24O1,29o2,33p2":                "WAIT",
+	"1 20 1/1 0/0 01 15p0,19O1,21o2,28P1,30p1,31p1":                "WAIT",
+	"1 20 1/1 0/0 01 15p0,20O1,22o2,29p2,31p1":                     "WAIT",
+	"1 20 1/1 0/0 01 17p0,19P1,25o1,26O1,28O1,34p1,35p1":           "SEED 19 7",
+	"1 20 1/1 0/0 01 17p0,25O1,27o2,34p1,36p2":                     "WAIT",
+	"1 20 1/1 0/0 01 19O0,20o2,21p0,22p1,29p2,31O1":                "WAIT",
+	"1 20 1/1 0/0 01 19P1,24p0,25p1,26p1,28o2,34O1":                "SEED 19 36",
+	"1 20 1/1 0/0 01 19p0,25O1,27O1,28o1,34p2,36p1":                "WAIT",
+	"1 20 1/1 0/0 01 20O1,24p0,25p1,26p1,29P1,33O0,34o2":           "SEED 29 13",
+	"1 20 1/1 0/0 01 20p0,21p1,24o2,30O1,33p2":                     "WAIT",
+	"1 20 1/1 0/0 01 20p2,27O1,28o1,29O1,35p0,36p1":                "WAIT",
+	"1 20 1/1 0/0 01 20p2,27p1,28p0,29o2,36O1":                     "WAIT",
+	"1 20 1/1 0/0 01 21O0,22O1,23p2,30p0,31p1,32o1,33o0":           "WAIT",
+	"1 20 1/1 0/0 01 21O0,22o2,25O1,30p1,31P1,34p1,35p0":           "WAIT",
+	"1 20 1/1 0/0 01 21O0,22o2,25O1,30p1,31p1,32p0,34P1":           "WAIT",
+	"1 20 1/1 0/0 01 21o2,22O0,23O1,29p0,30p1,31p1,32P1":           "WAIT",
+	"1 20 1/1 0/0 01 23O1,25o2,32p1,33p0,34p2":                     "WAIT",
+	"1 20 1/1 0/0 01 24O1,25o1,27p1,28p0,33p2,36O1":                "WAIT",
+	"1 20 1/1 0/0 01 24p2,26p1,27p0,33O1,35o2":                     "WAIT",
+	"1 20 1/1 0/0 01 7p0,13O0,20p1,26p2,29o2,35O1":                 "WAIT",
+	"1 20 1/1 0/0 01 8O0,14p0,21O1,22p2,30p1,31o2":                 "WAIT",
+	"1 20 1/1 0/0 01 8p0,14O0,21p1,24p2,30o2,33O1":                 "WAIT",
+	"1 20 1/1 0/0 01 9p0,21p1,24o2,30O1,33p2":                      "WAIT",
+	"1 20 1/2 0/0 00 18o0,25O1,26P1,27P0,34p2,35o1":                "WAIT",
+	"1 20 1/2 0/0 00 23o0,24o1,25p2,32P0,33P1,34O1":                "WAIT",
+	"1 20 1/2 0/0 01 18p0,19O1,27O1,28P1,29p1,36p1":                "WAIT",
+	"1 20 1/2 0/0 01 19O1,27O1,28P1,29p1,36P1":                     "SEED 36 18",
+	"1 20 1/2 0/0 01 19O1,27O1,28P1,36p2":                          "WAIT",
+	"1 20 1/2 0/0 01 25O1,26P1,27P0,34p2,35O1":                     "WAIT",
+	"1 20 1/2 0/0 01 25O1,26p2,34P1,35O1":                          "WAIT",
+	"1 20 1/3 0/0 00 19P0,25P0,26P1,27O1,28O0,34O0,35O1,36P1":      "WAIT",
+	"1 20 1/3 0/0 00 19o0,20p2,27p1,28p0,29o1,30o0,36o1":           "WAIT",
+	"1 20 1/3 0/0 00 22P1,24O1,25O0,31o1,32o0,33p2":                "SEED 22 23",
+	"1 20 1/4 0/0 00 10o0,16p1,23o1,27O1,32P1,36P1":                "WAIT",
+	"1 20 1/4 0/0 00 11P0,17o0,19p2,25P1,28O1,34o1":                "WAIT",
+	"1 20 1/4 0/0 00 12o0,18P0,21O1,26o1,30P1,35p2":                "SEED 30 14",
+	"1 20 1/4 0/0 00 19o0,20p2,27P1,29O1,36o1":                     "SEED 27 28",
+	"1 20 1/4 0/0 00 20O1,27P1,28p1,29P1,35o0,36o1":                "WAIT",
+	"1 20 1/4 0/0 00 20p2,27P1,29O1,35o0,36o1":                     "SEED 27 26",
+	"1 20 1/4 0/0 00 23O1,26o0,27o1,32P1,35p1,36P1":                "SEED 36 19",
+	"1 20 1/4 0/0 00 23P1,25p2,32o1,33o0,34O1":                     "WAIT",
+	"1 20 1/4 0/0 00 8o0,20o1,21O1,29P1,30p2,31P0":                 "SEED 29 14",
+	"1 20 1/4 0/0 01 19O1,20p2,28P1,29O1":                          "WAIT",
+	"1 20 1/4 0/0 01 20p2,26p0,27p1,29O1,35o0,36o1":                "WAIT",
+	"1 20 2/0 0/0 00 19P1,26p0,27p1,28O1,29O0,35O0,36o2":           "SEED 19 20",
+	"1 20 2/1 0/0 00 18p0,25P1,26O1,27O0,34o2,35p1":                "WAIT",
+	"1 20 2/1 0/0 00 23p0,24p1,25o2,32O0,33O1,34P1":                "WAIT",
+	"1 20 2/3 0/0 00 11O0,23P1,24O1,31O0,32O1,33P1":                "WAIT",
+	"1 20 2/3 0/0 00 18O0,19P1,27P1,28O1,29O0,36O1":                "WAIT",
+	"1 20 2/3 0/0 00 19P1,27P1,28O1,29O0,35O0,36O1":                "SEED 27 26",
+	"1 20 2/3 0/0 00 7O0,13O0,19P1,27P1,28O1,36O1":                 "WAIT",
+	"1 20 2/4 0/0 00 19P1,27P1,28O1,29O0,36O1":                     "WAIT",
+	"1 20 2/4 0/0 00 19P1,27P1,28O1,36O1":                          "WAIT",
+	"1 20 2/4 0/0 00 21P0,22P1,23O1,31O1,32P1":                     "SEED 32 33",
+	"1 20 2/4 0/0 00 23P1,24O1,32O1,33P1":                          "WAIT",
+	"1 20 2/4 0/0 00 24P1,25O1,32O0,33O1,34P1":                     "SEED 24 23",
+	"1 20 2/4 0/0 00 25P1,26O1,27O0,34O1,35P1":                     "SEED 35 18",
+	"1 20 2/4 0/0 00 25P1,26O1,34O1,35P1":                          "WAIT",
+	"1 20 3/0 0/0 00 11O0,17p0,24O1,25o2,33p1,34P1":                "GROW 34",
+	"1 20 3/0 0/0 00 24p0,25p1,26P1,34O1,35O1,36o1":                "GROW 26",
+	"1 20 3/0 0/0 00 8p0,14o0,20p1,21p1,22p0,29o1,30o2,31O0":       "WAIT",
+	"1 20 3/0 0/0 01 7p0,14O0,20p1,26O0,27o2,29O1,35p0,36p1":       "WAIT",
+	"1 20 3/1 0/0 00 19O0,25O0,26O1,27P1,28P0,34P0,35P1,36O1":      "GROW 27",
+	"1 20 3/1 0/0 00 19p0,20o2,27o1,28o0,29p1,30p0,36p1":           "WAIT",
+	"1 20 3/1 0/0 00 22O1,24P1,25P0,31p1,32p0,33o2":                "GROW 24",
+	"1 20 3/2 0/0 00 11P0,23O1,24P1,31P0,32P1,33O1":                "GROW 24",
+	"1 20 3/2 0/0 00 18P0,19O1,27O1,28P1,29P0,36P1":                "GROW 28",
+	"1 20 3/2 0/0 00 19O1,27O1,28P1,29P0,35P0,36P1":                "GROW 36",
+	"1 20 3/2 0/0 00 7P0,13P0,19O1,27O1,28P1,36P1":                 "GROW 28",
+	"1 20 3/3 0/0 00 11O0,24O1,25O1,33P1,34P1":                     "SEED 33 17",
+	"1 20 3/3 0/0 00 11P0,24P1,25P1,33O1,34O1":                     "GROW 25",
+	"1 20 3/3 0/0 00 12O0,26O1,27O1,35P1,36P1":                     "GROW 35",
+	"1 20 3/3 0/0 00 12P0,26P1,27P1,35O1,36O1":                     "GROW 27",
+	"1 20 3/3 0/0 00 14O0,20P0,21P1,24P1,25P0,30O1,33O1,34O0":      "GROW 21",
+	"1 20 3/3 0/0 00 14P0,20O0,21O1,24O1,25O0,30P1,33P1,34P0":      "GROW 33",
+	"1 20 3/3 0/0 00 15O0,22P0,23P1,25O1,26O0,32O1,34P1,35P0":      "GROW 35",
+	"1 20 3/3 0/0 00 15P0,22O0,23O1,25P1,26P0,32P1,34O1,35O0":      "GROW 32",
+	"1 20 3/3 0/0 00 16O0,19P1,23P1,24P0,27O0,28O1,32O1,36P0":      "GROW 24",
+	"1 20 3/3 0/0 00 16P0,19O1,23O1,24O0,27P0,28P1,32P1,36O0":      "GROW 32",
+	"1 20 3/3 0/0 00 19O1,20O0,21O1,28P1,29P0,30P1,31P0,36O0":      "GROW 30",
+	"1 20 3/3 0/0 00 19O1,27P1,28P1,36O1":                          "GROW 28",
+	"1 20 3/3 0/0 00 19P1,20P0,21P1,28O1,29O0,30O1,31O0,36P0":      "GROW 21",
+	"1 20 3/3 0/0 00 19P1,27O1,28O1,36P1":                          "GROW 36",
+	"1 20 3/3 0/0 00 20O0,21O1,23O1,24O0,29P0,30P1,32P1,33P0":      "GROW 30",
+	"1 20 3/3 0/0 00 20O0,21O1,24O1,25O0,29P0,30P1,32P0,33P1":      "GROW 29",
+	"1 20 3/3 0/0 00 20P0,21P1,23P1,24P0,29O0,30O1,32O1,33O0":      "GROW 24",
+	"1 20 3/3 0/0 00 20P0,21P1,24P1,25P0,29O0,30O1,32O0,33O1":      "GROW 21",
+	"1 20 3/3 0/0 00 21O0,22O1,26P1,27P0,30P0,31P1,34O0,35O1":      "GROW 30",
+	"1 20 3/3 0/0 00 21O0,22O1,27O1,28O0,31P1,32P0,35P0,36P1":      "GROW 32",
+	"1 20 3/3 0/0 00 21P0,22P1,26O1,27O0,30O0,31O1,34P0,35P1":      "GROW 21",
+	"1 20 3/3 0/0 00 21P0,22P1,27P1,28P0,31O1,32O0,35O0,36O1":      "GROW 22",
+	"1 20 3/3 0/0 00 22O1,23O1,31P1,32P1":                          "GROW 31",
+	"1 20 3/3 0/0 00 22P1,23P1,31O1,32O1":                          "GROW 22",
+	"1 20 3/3 0/0 00 23O1,24O1,25O0,32P1,33P1,34P0":                "GROW 34",
+	"1 20 3/3 0/0 00 23P1,24P1,25P0,32O1,33O1,34O0":                "GROW 25",
+	"1 20 3/3 0/0 00 25O0,26O1,27O1,35P1,36P1":                     "GROW 35",
+	"1 20 3/3 0/0 00 25O1,26O1,34P1,35P1,36P0":                     "GROW 36",
+	"1 20 3/3 0/0 00 25P0,26P1,27P1,35O1,36O1":                     "GROW 27",
+	"1 20 3/3 0/0 00 25P1,26P1,34O1,35O1,36O0":                     "SEED 25 24",
+	"1 20 3/3 0/0 00 26O1,27O1,35P1,36P1":                          "GROW 36",
+	"1 20 3/3 0/0 00 26P1,27P1,35O1,36O1":                          "GROW 27",
+	"1 20 3/3 0/0 00 7O0,12P0,13P0,18O0,19O1,26P1,28P1,35O1":       "GROW 28",
+	"1 20 3/3 0/0 00 7P0,12O0,13O0,18P0,19P1,26O1,28O1,35P1":       "GROW 35",
+	"1 20 3/3 0/0 00 8O0,21O1,25P1,26P0,29P0,30P1,34O1,35O0":       "GROW 25",
+	"1 20 3/3 0/0 00 8P0,21P1,25O1,26O0,29O0,30O1,34P1,35P0":       "GROW 34",
+	"1 20 3/3 0/0 00 9O0,15P0,21O1,24P0,25P1,30P1,34O1,35O0":       "GROW 30",
+	"1 20 3/3 0/0 00 9O0,15P0,21O1,25P1,26P0,30P1,34O1,35O0":       "GROW 25",
+	"1 20 3/3 0/0 00 9O0,15P0,23O1,25P1,26P0,32P1,34O1,35O0":       "GROW 26",
+	"1 20 3/3 0/0 00 9P0,15O0,21P1,24O0,25O1,30O1,34P1,35P0":       "GROW 35",
+	"1 20 3/3 0/0 00 9P0,15O0,21P1,25O1,26O0,30O1,34P1,35P0":       "GROW 35",
+	"1 20 3/3 0/0 00 9P0,15O0,23P1,25O1,26O0,32O1,34P1,35P0":       "GROW 23",
+	"1 20 3/4 0/0 00 11P0,17O0,19P1,20P0,26P1,28O1,35O1":           "GROW 11",
+	"1 20 3/4 0/0 00 11P0,19O1,24P1,27P0,28P1,33O1":                "GROW 27",
+	"1 20 3/4 0/0 00 11P0,22P1,23P0,25P1,31O1,33O0,34O1":           "GROW 22",
+	"1 20 3/4 0/0 00 12P0,20O1,27P1,29P1,30P0,36O1":                "GROW 27",
+	"1 20 3/4 0/0 00 12P0,22P1,23P0,26P1,31O1,32O0,35O1":           "GROW 26",
+	"1 20 3/4 0/0 00 12P0,24P0,25P1,27P1,34O1,36O1":                "GROW 12",
+	"1 20 3/4 0/0 00 13P0,20O1,21O1,29P1,30P1,31P0":                "GROW 29",
+	"1 20 3/4 0/0 00 13P0,20O1,21P1,22P0,29P1,30O1":                "GROW 29",
+	"1 20 3/4 0/0 00 13P0,22O1,27P1,30P0,31P1,36O1":                "GROW 31",
+	"1 20 3/4 0/0 00 14O0,20P0,21P1,23P0,24P1,30O1,33O1":           "GROW 23",
+	"1 20 3/4 0/0 00 14P0,20O1,26P0,27P1,29P1,36O1":                "GROW 27",
+	"1 20 3/4 0/0 00 14P0,20O1,26P1,27P0,29P1,35O1,36O0":           "GROW 29",
+	"1 20 3/4 0/0 00 14P0,21O1,24O1,30P1,33P1,34P0":                "GROW 30",
+	"1 20 3/4 0/0 00 15P0,19O0,23O1,27P1,28P0,32P1,36O1":           "GROW 28",
+	"1 20 3/4 0/0 00 15P0,19P1,20P0,23O1,28O1,32P1":                "GROW 19",
+	"1 20 3/4 0/0 00 15P0,21O1,27P1,28P0,30P1,36O1":                "GROW 27",
+	"1 20 3/4 0/0 00 17O0,19P1,20P0,23P0,24P1,28O1,33O1":           "GROW 23",
+	"1 20 3/4 0/0 00 17O0,23P0,24P1,26P0,27P1,33O1,36O1":           "GROW 24",
+	"1 20 3/4 0/0 00 17P0,19P0,24O1,27O1,28O0,33P1,36P1":           "GROW 33",
+	"1 20 3/4 0/0 00 17P0,21O1,26O1,29P0,30P1,35P1":                "GROW 17",
+	"1 20 3/4 0/0 00 17P0,24O1,25O0,27O1,33P1,35P0,36P1":           "GROW 35",
+	"1 20 3/4 0/0 00 18P0,21O1,26O1,29P0,30P1,35P1":                "GROW 35",
+	"1 20 3/4 0/0 00 18P0,23O1,26O1,32P1,33P0,35P1":                "GROW 35",
+	"1 20 3/4 0/0 00 18P0,23O1,27O1,31P0,32P1,36P1":                "GROW 36",
+	"1 20 3/4 0/0 00 19O0,25O1,27P1,28P0,33P0,34P1,36O1":           "GROW 28",
+	"1 20 3/4 0/0 00 19O1,22O0,23O1,27P0,28P1,31P0,32P1":           "GROW 31",
+	"1 20 3/4 0/0 00 19O1,24P0,25P1,28P1,29P0,33O0,34O1":           "GROW 25",
+	"1 20 3/4 0/0 00 19P0,20P1,22O1,29O1,31P1,32P0":                "GROW 19",
+	"1 20 3/4 0/0 00 19P0,20P1,25P1,26P0,28O0,29O1,34O1":           "GROW 20",
+	"1 20 3/4 0/0 00 19P1,20P0,22P1,23P0,28O1,31O1":                "GROW 22",
+	"1 20 3/4 0/0 00 19P1,24P0,25P1,28O1,34O1,36P0":                "GROW 36",
+	"1 20 3/4 0/0 00 20O1,21O1,28P0,29P1,30P1,31P0":                "GROW 29",
+	"1 20 3/4 0/0 00 20O1,26O1,29P1,30P0,34P0,35P1":                "GROW 29",
+	"1 20 3/4 0/0 00 20P0,21P1,22P0,23P1,30O1,31O0,32O1":           "GROW 23",
+	"1 20 3/4 0/0 00 20P0,21P1,22P1,23P0,30O1,31O1":                "GROW 22",
+	"1 20 3/4 0/0 00 20P1,21P0,22P0,23P1,29O1,30O0,32O1":           "GROW 23",
+	"1 20 3/4 0/0 00 20P1,21P0,23P0,24P1,29O1,30O0,33O1":           "GROW 23",
+	"1 20 3/4 0/0 00 21O1,23P1,24P0,29P0,30P1,32O1":                "GROW 23",
+	"1 20 3/4 0/0 00 21P1,22P0,25P1,26P0,30O1,34O1":                "GROW 25",
+	"1 20 3/4 0/0 00 22O1,24P1,25P0,30P0,31P1,33O1":                "GROW 31",
+	"1 20 3/4 0/0 00 22P0,23P1,25O1,26O0,32O1,34P1,35P0":           "GROW 23",
+	"1 20 3/4 0/0 00 22P1,23P0,24P1,25P0,31O1,33O1":                "GROW 24",
+	"1 20 3/4 0/0 00 23P0,24P1,26O1,33O1,34P0,35P1":                "GROW 34",
+	"1 20 3/4 0/0 00 7P0,13O0,17P0,20P1,26O1,29O1,35P1":            "GROW 35",
+	"1 20 3/4 0/0 00 7P0,13O0,20P1,26O1,29O1,34P0,35P1":            "GROW 34",
+	"1 20 3/4 0/0 00 7P0,17P0,24O1,27O1,33P1,36P1":                 "GROW 17",
+	"1 20 3/4 0/0 00 7P0,19P1,22O0,23O1,28O1,32P1,33P0":            "GROW 19",
+	"1 20 3/4 0/0 00 7P0,19P1,22O1,28O1,31P1,32P0":                 "GROW 19",
+	"1 20 3/4 0/0 00 7P0,19P1,25O1,28O1,29O0,34P1,35P0":            "GROW 35",
+	"1 20 3/4 0/0 00 7P0,20P1,23O1,29O1,31P0,32P1":                 "GROW 32",
+	"1 20 3/4 0/0 00 7P0,20P1,23O1,29O1,32P1,33P0":                 "GROW 33",
+	"1 20 3/4 0/0 00 8P0,10P0,20P1,24P1,29O1,33O1":                 "GROW 20",
+	"1 20 3/4 0/0 00 8P0,11P0,21P1,25P1,30O1,34O1":                 "GROW 25",
+	"1 20 3/4 0/0 00 8P0,21P1,23P1,24P0,30O1,32O1,33O0":            "GROW 21",
+	"1 20 3/4 0/0 00 9P0,12P0,15O0,22P1,26P1,31O1,35O1":            "GROW 22",
+	"1 20 3/4 0/0 00 9P0,19O0,20O1,21P1,28P0,29P1,30O1":            "GROW 21",
+	"1 20 3/4 0/0 00 9P0,21O1,23P1,29P0,30P1,32O1":                 "SEED 30 15",
+	"1 20 3/4 0/0 00 9P0,22P1,24P1,25P0,31O1,33O1":                 "GROW 25",
+	"1 20 4/0 0/0 00 18O0,21p1,22p0,26P1,29O0,30O1,35o2":           "GROW 26",
+	"1 20 4/0 0/0 00 19p0,20p1,21P1,28O0,29o2,30O1,31O0":           "GROW 21",
+	"1 20 4/0 0/0 00 20P1,25p0,26p1,29o2,30O0,34O0,35O1":           "GROW 20",
+	"1 20 4/0 0/0 00 7p0,13O0,20p1,21O1,22O0,29o2,30P1":            "GROW 30",
+	"1 20 4/0 0/0 00 7p0,14O0,20p1,26O0,27o2,29O1,36P1":            "SEED 36 35",
+	"1 20 4/0 0/0 00 8O0,11O0,14p0,21O1,25o2,30p1,34P1":            "GROW 34",
+	"1 20 4/1 0/0 00 10p0,16o1,23p1,27P1,32O1,36O1":                "GROW 27",
+	"1 20 4/1 0/0 00 11O0,17p0,19o2,25O1,28P1,34p1":                "GROW 28",
+	"1 20 4/1 0/0 00 12p0,18O0,21P1,26p1,30O1,35o2":                "GROW 21",
+	"1 20 4/1 0/0 00 19p0,20o2,27O1,29P1,36p1":                     "SEED 29 30",
+	"1 20 4/1 0/0 00 20P1,27O1,28o1,29O1,35p0,36p1":                "GROW 20",
+	"1 20 4/1 0/0 00 20o2,27O1,29P1,35p0,36p1":                     "WAIT",
+	"1 20 4/1 0/0 00 23O1,25o2,32p1,33p0,34P1":                     "GROW 34",
+	"1 20 4/1 0/0 00 23P1,26p0,27p1,32O1,35o1,36O1":                "GROW 23",
+	"1 20 4/1 0/0 00 8p0,20p1,21P1,29O1,30o2,31O0":                 "SEED 21 22",
+	"1 20 4/2 0/0 00 19O1,27O1,28P1,29P0,36P1":                     "GROW 29",
+	"1 20 4/2 0/0 00 19O1,27O1,28P1,36P1":                          "GROW 36",
+	"1 20 4/2 0/0 00 21O0,22O1,23P1,31P1,32O1":                     "GROW 23",
+	"1 20 4/2 0/0 00 23O1,24P1,32P1,33O1":                          "WAIT",
+	"1 20 4/2 0/0 00 24O1,25P1,32P0,33P1,34O1":                     "GROW 25",
+	"1 20 4/2 0/0 00 25O1,26P1,27P0,34P1,35O1":                     "GROW 34",
+	"1 20 4/2 0/0 00 25O1,26P1,34P1,35O1":                          "GROW 26",
+	"1 20 4/3 0/0 00 11O0,17P0,19O1,20O0,26O1,28P1,35P1":           "GROW 35",
+	"1 20 4/3 0/0 00 11O0,19P1,24O1,27O0,28O1,33P1":                "GROW 19",
+	"1 20 4/3 0/0 00 11O0,22O1,23O0,25O1,31P1,33P0,34P1":           "GROW 34",
+	"1 20 4/3 0/0 00 12O0,20P1,27O1,29O1,30O0,36P1":                "GROW 36",
+	"1 20 4/3 0/0 00 12O0,22O1,23O0,26O1,31P1,32P0,35P1":           "GROW 35",
+	"1 20 4/3 0/0 00 12O0,24O0,25O1,27O1,34P1,36P1":                "GROW 34",
+	"1 20 4/3 0/0 00 13O0,20P1,21O1,22O0,29O1,30P1":                "SEED 20 7",
+	"1 20 4/3 0/0 00 13O0,20P1,21P1,29O1,30O1,31O0":                "GROW 21",
+	"1 20 4/3 0/0 00 13O0,22P1,27O1,30O0,31O1,36P1":                "GROW 36",
+	"1 20 4/3 0/0 00 14O0,20P1,26O0,27O1,29O1,36P1":                "SEED 20 7",
+	"1 20 4/3 0/0 00 14O0,20P1,26O1,27O0,29O1,35P1,36P0":           "GROW 36",
+	"1 20 4/3 0/0 00 14O0,21P1,24P1,30O1,33O1,34O0":                "GROW 24",
+	"1 20 4/3 0/0 00 14P0,20O0,21O1,23O0,24O1,30P1,33P1":           "GROW 30",
+	"1 20 4/3 0/0 00 15O0,19O1,20O0,23P1,28P1,32O1":                "GROW 28",
+	"1 20 4/3 0/0 00 15O0,19P0,23P1,27O1,28O0,32O1,36P1":           "GROW 19",
+	"1 20 4/3 0/0 00 15O0,21P1,27O1,28O0,30O1,36P1":                "GROW 21",
+	"1 20 4/3 0/0 00 17O0,19O0,24P1,27P1,28P0,33O1,36O1":           "GROW 28",
+	"1 20 4/3 0/0 00 17O0,21P1,26P1,29O0,30O1,35O1":                "GROW 21",
+	"1 20 4/3 0/0 00 17O0,24P1,25P0,27P1,33O1,35O0,36O1":           "GROW 25",
+	"1 20 4/3 0/0 00 17P0,19O1,20O0,23O0,24O1,28P1,33P1":           "GROW 33",
+	"1 20 4/3 0/0 00 17P0,23O0,24O1,26O0,27O1,33P1,36P1":           "GROW 33",
+	"1 20 4/3 0/0 00 18O0,21P1,26P1,29O0,30O1,35O1":                "SEED 21 22",
+	"1 20 4/3 0/0 00 18O0,23P1,26P1,32O1,33O0,35O1":                "GROW 23",
+	"1 20 4/3 0/0 00 18O0,23P1,27P1,31O0,32O1,36O1":                "GROW 23",
+	"1 20 4/3 0/0 00 19O0,20O1,22P1,29P1,31O1,32O0":                "GROW 29",
+	"1 20 4/3 0/0 00 19O0,20O1,25O1,26O0,28P0,29P1,34P1":           "GROW 29",
+	"1 20 4/3 0/0 00 19O1,20O0,22O1,23O0,28P1,31P1":                "GROW 28",
+	"1 20 4/3 0/0 00 19O1,24O0,25O1,28P1,34P1,36O0":                "GROW 28",
+	"1 20 4/3 0/0 00 19P0,25P1,27O1,28O0,33O0,34O1,36P1":           "GROW 19",
+	"1 20 4/3 0/0 00 19P1,22P0,23P1,27O0,28O1,31O0,32O1":           "GROW 22",
+	"1 20 4/3 0/0 00 19P1,24O0,25O1,28O1,29O0,33P0,34P1":           "GROW 34",
+	"1 20 4/3 0/0 00 20O0,21O1,22O0,23O1,30P1,31P0,32P1":           "GROW 30",
+	"1 20 4/3 0/0 00 20O0,21O1,22O1,23O0,30P1,31P1":                "GROW 31",
+	"1 20 4/3 0/0 00 20O1,21O0,22O0,23O1,29P1,30P0,32P1":           "GROW 29",
+	"1 20 4/3 0/0 00 20O1,21O0,23O0,24O1,29P1,30P0,33P1":           "GROW 30",
+	"1 20 4/3 0/0 00 20P1,21P1,28O0,29O1,30O1,31O0":                "SEED 20 19",
+	"1 20 4/3 0/0 00 20P1,26P1,29O1,30O0,34O0,35O1":                "SEED 26 25",
+	"1 20 4/3 0/0 00 21O1,22O0,25O1,26O0,30P1,34P1":                "GROW 34",
+	"1 20 4/3 0/0 00 21P1,23O1,24O0,29O0,30O1,32P1":                "GROW 32",
+	"1 20 4/3 0/0 00 22O0,23O1,25P1,26P0,32P1,34O1,35O0":           "GROW 32",
+	"1 20 4/3 0/0 00 22O1,23O0,24O1,25O0,31P1,33P1":                "GROW 31",
+	"1 20 4/3 0/0 00 22P1,24O1,25O0,30O0,31O1,33P1":                "GROW 33",
+	"1 20 4/3 0/0 00 23O0,24O1,26P1,33P1,34O0,35O1":                "GROW 33",
+	"1 20 4/3 0/0 00 7O0,13P0,17O0,20O1,26P1,29P1,35O1":            "GROW 13",
+	"1 20 4/3 0/0 00 7O0,13P0,20O1,26P1,29P1,34O0,35O1":            "GROW 13",
+	"1 20 4/3 0/0 00 7O0,17O0,24P1,27P1,33O1,36O1":                 "GROW 24",
+	"1 20 4/3 0/0 00 7O0,19O1,22P0,23P1,28P1,32O1,33O0":            "GROW 22",
+	"1 20 4/3 0/0 00 7O0,19O1,22P1,28P1,31O1,32O0":                 "GROW 28",
+	"1 20 4/3 0/0 00 7O0,19O1,25P1,28P1,29P0,34O1,35O0":            "GROW 28",
+	"1 20 4/3 0/0 00 7O0,20O1,23P1,29P1,31O0,32O1":                 "GROW 23",
+	"1 20 4/3 0/0 00 7O0,20O1,23P1,29P1,32O1,33O0":                 "GROW 23",
+	"1 20 4/3 0/0 00 8O0,10O0,20O1,24O1,29P1,33P1":                 "GROW 29",
+	"1 20 4/3 0/0 00 8O0,11O0,21O1,25O1,30P1,34P1":                 "SEED 30 14",
+	"1 20 4/3 0/0 00 8O0,21O1,23O1,24O0,30P1,32P1,33P0":            "GROW 33",
+	"1 20 4/3 0/0 00 9O0,12O0,15P0,22O1,26O1,31P1,35P1":            "GROW 15",
+	"1 20 4/3 0/0 00 9O0,19P0,20P1,21O1,28O0,29O1,30P1":            "WAIT",
+	"1 20 4/3 0/0 00 9O0,21P1,23O1,29O0,30O1,32P1":                 "GROW 21",
+	"1 20 4/3 0/0 00 9O0,22O1,24O1,25O0,31P1,33P1":                 "GROW 31",
+	"1 20 4/4 0/0 00 10O0,22O1,24O1,31P1,33P1":                     "GROW 31",
+	"1 20 4/4 0/0 00 10P0,22P1,24P1,31O1,33O1":                     "GROW 22",
+	"1 20 4/4 0/0 00 11O0,19O1,25O1,28P1,34P1":                     "SEED 34 17",
+	"1 20 4/4 0/0 00 11O0,19O1,26O1,28P1,34P0,35P1":                "GROW 34",
+	"1 20 4/4 0/0 00 11P0,19P1,25P1,28O1,34O1":                     "GROW 19",
+	"1 20 4/4 0/0 00 11P0,19P1,26P1,28O1,34O0,35O1":                "GROW 19",
+	"1 20 4/4 0/0 00 13O0,20P1,26P1,29O1,35O1":                     "GROW 26",
+	"1 20 4/4 0/0 00 13P0,20O1,26O1,29P1,35P1":                     "GROW 29",
+	"1 20 4/4 0/0 00 14O0,20P1,24O1,29O1,33P1":                     "GROW 33",
+	"1 20 4/4 0/0 00 14O0,21P1,24P1,30O1,33O1":                     "GROW 24",
+	"1 20 4/4 0/0 00 14P0,20O1,24P1,29P1,33O1":                     "GROW 29",
+	"1 20 4/4 0/0 00 14P0,21O1,24O1,30P1,33P1":                     "GROW 30",
+	"1 20 4/4 0/0 00 16O0,23P1,27P1,32O1,36O1":                     "SEED 23 10",
+	"1 20 4/4 0/0 00 16P0,23O1,27O1,32P1,36P1":                     "GROW 16",
+	"1 20 4/4 0/0 00 18O0,21P1,26P1,30O1,35O1":                     "SEED 26 12",
+	"1 20 4/4 0/0 00 18P0,21O1,26O1,30P1,35P1":                     "GROW 35",
+	"1 20 4/4 0/0 00 19O0,20O1,21P1,28P0,29P1,30O1":                "GROW 29",
+	"1 20 4/4 0/0 00 19O0,20O1,22P1,29P1,31O1":                     "GROW 29",
+	"1 20 4/4 0/0 00 19O0,20O1,25O1,28P0,29P1,34P1":                "GROW 28",
+	"1 20 4/4 0/0 00 19O1,20O1,28P1,29P1,30P0":                     "GROW 28",
+	"1 20 4/4 0/0 00 19O1,20P1,28P1,29O1":                          "GROW 20",
+	"1 20 4/4 0/0 00 19O1,21O1,28P1,30P1,31P0":                     "GROW 31",
+	"1 20 4/4 0/0 00 19O1,21P1,28P1,30O1":                          "GROW 28",
+	"1 20 4/4 0/0 00 19O1,23O1,24O0,28P1,32P1,33P0":                "GROW 33",
+	"1 20 4/4 0/0 00 19O1,23P1,27P0,28P1,32O1,36O0":                "GROW 27",
+	"1 20 4/4 0/0 00 19O1,24P1,27P0,28P1,33O1":                     "GROW 27",
+	"1 20 4/4 0/0 00 19O1,25O1,26O0,28P1,34P1":                     "GROW 28",
+	"1 20 4/4 0/0 00 19O1,25O1,28P1,34P1":                          "GROW 28",
+	"1 20 4/4 0/0 00 19O1,25P0,26P1,28P1,34O0,35O1":                "GROW 25",
+	"1 20 4/4 0/0 00 19O1,25P1,28P1,33O0,34O1":                     "GROW 25",
+	"1 20 4/4 0/0 00 19P0,20P1,21O1,28O0,29O1,30P1":                "GROW 20",
+	"1 20 4/4 0/0 00 19P0,20P1,22O1,29O1,31P1":                     "GROW 20",
+	"1 20 4/4 0/0 00 19P0,20P1,25P1,28O0,29O1,34O1":                "GROW 19",
+	"1 20 4/4 0/0 00 19P1,20O1,28O1,29P1":                          "WAIT",
+	"1 20 4/4 0/0 00 19P1,20P1,28O1,29O1,30O0":                     "GROW 20",
+	"1 20 4/4 0/0 00 19P1,21O1,28O1,30P1":                          "GROW 19",
+	"1 20 4/4 0/0 00 19P1,21P1,28O1,30O1,31O0":                     "GROW 21",
+	"1 20 4/4 0/0 00 19P1,23O1,27O0,28O1,32P1,36P0":                "GROW 36",
+	"1 20 4/4 0/0 00 19P1,23P1,24P0,28O1,32O1,33O0":                "GROW 24",
+	"1 20 4/4 0/0 00 19P1,24O1,27O0,28O1,33P1":                     "GROW 19",
+	"1 20 4/4 0/0 00 19P1,25O0,26O1,28O1,34P0,35P1":                "GROW 34",
+	"1 20 4/4 0/0 00 19P1,25O1,28O1,33P0,34P1":                     "GROW 19",
+	"1 20 4/4 0/0 00 19P1,25P1,26P0,28O1,34O1":                     "GROW 26",
+	"1 20 4/4 0/0 00 19P1,25P1,28O1,34O1":                          "GROW 19",
+	"1 20 4/4 0/0 00 20O1,21O1,29P1,30P1,31P0":                     "GROW 30",
+	"1 20 4/4 0/0 00 20O1,22O1,29P1,31P1":                          "GROW 29",
+	"1 20 4/4 0/0 00 20O1,23O1,29P1,32P1":                          "GROW 32",
+	"1 20 4/4 0/0 00 20O1,25P1,26P0,29P1,33O0,34O1":                "GROW 26",
+	"1 20 4/4 0/0 00 20O1,25P1,29P1,30P0,33O0,34O1":                "GROW 29",
+	"1 20 4/4 0/0 00 20O1,27O1,29P1,36P1":                          "SEED 36 35",
+	"1 20 4/4 0/0 00 20O1,27P1,28P0,29P1,36O1":                     "GROW 28",
+	"1 20 4/4 0/0 00 20P1,21P1,29O1,30O1,31O0":                     "SEED 20 8",
+	"1 20 4/4 0/0 00 20P1,22P1,29O1,31O1":                          "GROW 22",
+	"1 20 4/4 0/0 00 20P1,23P1,29O1,32O1":                          "GROW 23",
+	"1 20 4/4 0/0 00 20P1,25O1,26O0,29O1,33P0,34P1":                "GROW 34",
+	"1 20 4/4 0/0 00 20P1,25O1,29O1,30O0,33P0,34P1":                "GROW 34",
+	"1 20 4/4 0/0 00 20P1,27O1,28O0,29O1,36P1":                     "SEED 36 35",
+	"1 20 4/4 0/0 00 20P1,27P1,29O1,36O1":                          "GROW 20",
+	"1 20 4/4 0/0 00 21O0,22O1,25O1,30P0,31P1,34P1":                "GROW 30",
+	"1 20 4/4 0/0 00 21O0,22O1,26P1,30P0,31P1,35O1":                "GROW 26",
+	"1 20 4/4 0/0 00 21O1,22O0,23O1,30P1,31P0,32P1":                "GROW 31",
+	"1 20 4/4 0/0 00 21O1,23P1,29P0,30P1,32O1":                     "GROW 29",
+	"1 20 4/4 0/0 00 21O1,24P1,30P1,33O1":                          "GROW 24",
+	"1 20 4/4 0/0 00 21O1,26O1,30P1,35P1":                          "GROW 30",
+	"1 20 4/4 0/0 00 21O1,26P1,30P1,35O1":                          "GROW 26",
+	"1 20 4/4 0/0 00 21P0,22P1,25P1,30O0,31O1,34O1":                "GROW 22",
+	"1 20 4/4 0/0 00 21P0,22P1,26O1,30O0,31O1,35P1":                "GROW 35",
+	"1 20 4/4 0/0 00 21P1,22P0,23P1,30O1,31O0,32O1":                "GROW 21",
+	"1 20 4/4 0/0 00 21P1,23O1,29O0,30O1,32P1":                     "GROW 21",
+	"1 20 4/4 0/0 00 21P1,24O1,30O1,33P1":                          "GROW 33",
+	"1 20 4/4 0/0 00 21P1,26O1,30O1,35P1":                          "GROW 35",
+	"1 20 4/4 0/0 00 21P1,26P1,30O1,35O1":                          "GROW 26",
+	"1 20 4/4 0/0 00 22O1,23O0,25P1,31P1,32P0,34O1":                "GROW 25",
+	"1 20 4/4 0/0 00 22O1,24P1,25P0,31P1,33O1":                     "SEED 31 32",
+	"1 20 4/4 0/0 00 22P1,23P0,25O1,31O1,32O0,34P1":                "GROW 34",
+	"1 20 4/4 0/0 00 22P1,24O1,25O0,31O1,33P1":                     "GROW 33",
+	"1 20 4/4 0/0 00 23O1,25O1,32P1,34P1":                          "SEED 32 33",
+	"1 20 4/4 0/0 00 23O1,26P1,32P1,35O1":                          "GROW 32",
+	"1 20 4/4 0/0 00 23O1,27O1,32P1,35P0,36P1":                     "GROW 35",
+	"1 20 4/4 0/0 00 23P1,25P1,32O1,34O1":                          "GROW 25",
+	"1 20 4/4 0/0 00 23P1,26O1,32O1,35P1":                          "GROW 23",
+	"1 20 4/4 0/0 00 23P1,27P1,32O1,35O0,36O1":                     "SEED 27 26",
+	"1 20 4/4 0/0 00 24O1,25O0,27P1,33P1,36O1":                     "GROW 33",
+	"1 20 4/4 0/0 00 24O1,26O1,33P1,35P1":                          "GROW 35",
+	"1 20 4/4 0/0 00 24P1,25P0,27O1,33O1,36P1":                     "GROW 25",
+	"1 20 4/4 0/0 00 24P1,26P1,33O1,35O1":                          "GROW 24",
+	"1 20 4/4 0/0 00 25O1,27O1,28O0,34P1,36P1":                     "GROW 34",
+	"1 20 4/4 0/0 00 25O1,27O1,34P1,36P1":                          "GROW 36",
+	"1 20 4/4 0/0 00 25O1,27P1,33P0,34P1,36O1":                     "GROW 34",
+	"1 20 4/4 0/0 00 25P1,27O1,33O0,34O1,36P1":                     "GROW 25",
+	"1 20 4/4 0/0 00 25P1,27P1,28P0,34O1,36O1":                     "GROW 28",
+	"1 20 4/4 0/0 00 25P1,27P1,34O1,36O1":                          "GROW 27",
+	"1 20 4/4 0/0 00 7O0,13P0,20O1,26P1,29P1,35O1":                 "GROW 13",
+	"1 20 4/4 0/0 00 7P0,13O0,20P1,26O1,29O1,35P1":                 "GROW 7",
+	"1 20 4/4 0/0 00 8O0,21O1,22P1,23P0,30P1,31O1":                 "GROW 22",
+	"1 20 4/4 0/0 00 8O0,21O1,22P1,29P0,30P1,31O1":                 "GROW 22",
+	"1 20 4/4 0/0 00 8O0,21O1,22P1,30P1,31O1":                      "GROW 22",
+	"1 20 4/4 0/0 00 8P0,21P1,22O1,23O0,30O1,31P1":                 "GROW 21",
+	"1 20 4/4 0/0 00 8P0,21P1,22O1,29O0,30O1,31P1":                 "GROW 21",
+	"1 20 4/4 0/0 00 8P0,21P1,22O1,30O1,31P1":                      "GROW 31",
+	"1 20 4/4 0/0 00 9O0,15P0,22O1,25P1,31P1,34O1":                 "GROW 31",
+	"1 20 4/4 0/0 00 9P0,15O0,22P1,25O1,31O1,34P1":                 "GROW 34",
+}
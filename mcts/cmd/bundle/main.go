@@ -0,0 +1,113 @@
+// Command bundle writes the bot as the single source file CodinGame accepts: the Go files of the
+// bot, without their tests, merged into one package main with their imports gathered at the top.
+// From the mcts directory:
+//
+//	go run ./cmd/bundle > codingame.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*					BUNDLE						*/
+/*												*/
+/************************************************/
+
+// Bundle returns the source of the Go files of dir, merged into a single file: the tests and the
+// files the build constraints leave out of a default build are excluded
+func Bundle(dir string) ([]byte, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	imports := map[string]bool{}
+	bodies := bytes.Buffer{}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		isBuilt, err := build.Default.MatchFile(filepath.Dir(path), filepath.Base(path))
+		if err != nil {
+			return nil, err
+		}
+		if !isBuilt || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(fset, path, src, parser.ImportsOnly)
+		if err != nil {
+			return nil, err
+		}
+		if f.Name.Name != "main" {
+			return nil, fmt.Errorf("%s: package %s, not main", path, f.Name.Name)
+		}
+
+		// the body of the file starts after its last import, or after the package clause
+		start := fset.Position(f.Name.End()).Offset
+		for _, spec := range f.Imports {
+			imported, _ := strconv.Unquote(spec.Path.Value)
+			if spec.Name != nil {
+				imported = spec.Name.Name + " " + strconv.Quote(imported)
+			} else {
+				imported = strconv.Quote(imported)
+			}
+			imports[imported] = true
+		}
+		for _, decl := range f.Decls {
+			start = max(start, fset.Position(decl.End()).Offset)
+		}
+		fmt.Fprintf(&bodies, "\n// %s\n", filepath.Base(path))
+		bodies.Write(src[start:])
+	}
+
+	sortedImports := []string{}
+	for imported := range imports {
+		sortedImports = append(sortedImports, imported)
+	}
+	sort.Strings(sortedImports)
+
+	out := bytes.Buffer{}
+	out.WriteString("// Code generated by cmd/bundle; DO NOT EDIT.\n\npackage main\n\nimport (\n")
+	for _, imported := range sortedImports {
+		fmt.Fprintf(&out, "\t%s\n", imported)
+	}
+	out.WriteString(")\n")
+	out.Write(bodies.Bytes())
+	return format.Source(out.Bytes())
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the bot")
+	outPath := flag.String("out", "", "file the bundle is written to, stdout by default")
+	flag.Parse()
+
+	src, err := Bundle(*dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *outPath == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*outPath, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+/************************************************/
+/*												*/
+/*					EVALUATION					*/
+/*												*/
+/************************************************/
+
+// Evaluate estimates a state in points for the searches that stop before the end of the game.
+// Each player is worth its score, its sun at the end-of-game rate, what its trees can still bring
+// and the sun income of the next days. A finished game is worth its value under the objective.
+
+const (
+	// the income of the next day is counted for this many days, at most the days left
+	EVAL_INCOME_DAYS = 3
+)
+
+// treeValues are the points a tree of each size is worth while there are days left to complete it
+var treeValues = [4]float64{0.5, 1, 2, 4}
+
+// GetNextIncome returns the sun points the trees of each player, dormant ones included,
+// collect at the start of the next day
+func (s State) GetNextIncome() [2]int {
+	next := s
+	next.day++
+	next = next.UpdateShadows()
+	income := [2]int{}
+	for i := 0; i < 2; i++ {
+		for _, trees := range [][]int{s.activeTreesIndex[i], s.dormantTreesIndex[i]} {
+			for _, treeIndex := range trees {
+				size := s.treeMap[treeIndex]
+				isShaded := false
+				for shadowIndex := size - 1; shadowIndex < 3 && size > 0; shadowIndex++ {
+					isShaded = isShaded || next.shadowMap[shadowIndex][treeIndex] > 0
+				}
+				if size > 0 && !isShaded {
+					income[i] += size
+				}
+			}
+		}
+	}
+	return income
+}
+
+// GetPlayerValue returns the points playerCode is worth in s, see Evaluate
+func (s State) GetPlayerValue(playerCode int, income int) float64 {
+	daysLeft := rules.NbDays - s.day
+	value := float64(s.score[playerCode]) + float64(s.sun[playerCode])/3
+	for _, trees := range [][]int{s.activeTreesIndex[playerCode], s.dormantTreesIndex[playerCode]} {
+		for _, treeIndex := range trees {
+			// growing to size 3 and completing takes a day per action
+			if size := s.treeMap[treeIndex]; size >= 0 && daysLeft > 3-size {
+				value += treeValues[size]
+			}
+		}
+	}
+	return value + float64(income*min(daysLeft-1, EVAL_INCOME_DAYS))/3
+}
+
+// Evaluate returns the value of s for PLAYER, in points: its value less that of OPPONENT
+func Evaluate(s State) float64 {
+	if s.day >= rules.NbDays {
+		return GetTerminalValue(s)
+	}
+	income := s.GetNextIncome()
+	return s.GetPlayerValue(PLAYER, income[PLAYER]) - s.GetPlayerValue(OPPONENT, income[OPPONENT])
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+)
+
+/************************************************/
+/*												*/
+/*				PANIC RECOVERY					*/
+/*												*/
+/************************************************/
+
+// SafeChoose is Choose for the live bot: a panic in the strategy does not kill the process, the state
+// is dumped on stderr in state notation (load it in the analyze shell to reproduce) along with the
+// stack, the strategy is reset if it can be and the move of FallbackMove is returned instead, with
+// ok false: the caller tells the strategy which move was played
+func SafeChoose(strategy Strategy, state State, budget Budget) (move Move, ok bool) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		fmt.Fprintln(os.Stderr, "panic:", r)
+		fmt.Fprintln(os.Stderr, "state:", FormatState(state, true))
+		os.Stderr.Write(debug.Stack())
+
+		move, ok = FallbackMove(state), false
+		if resettable, isResettable := strategy.(Resettable); isResettable {
+			resettable.Reset()
+		}
+	}()
+	return strategy.Choose(state, budget), true
+}
+
+// FallbackMove returns a move without searching: complete the biggest trees in the last days,
+// otherwise grow the biggest tree that can be grown, otherwise wait.
+// It waits if anything goes wrong, waiting is always legal.
+func FallbackMove(s State) (move Move) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintln(os.Stderr, "fallback panic:", r)
+			move = Move{code: WAIT}
+		}
+	}()
+
+	move = Move{code: WAIT}
+	bestSize := -1
+	for _, m := range s.GetLegalMoves(PLAYER) {
+		switch m.code {
+		case COMPLETE:
+			if s.day >= rules.NbDays-FALLBACK_COMPLETE_DAYS {
+				return m
+			}
+		case GROW:
+			if size := s.treeMap[m.treeIndex]; size > bestSize {
+				bestSize = size
+				move = m
+			}
+		}
+	}
+	return move
+}
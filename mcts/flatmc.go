@@ -0,0 +1,93 @@
+package main
+
+import (
+	"math/rand"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*				FLAT MONTE CARLO				*/
+/*												*/
+/************************************************/
+
+// The flat Monte Carlo strategies are the baseline of the tree searches: every legal move of the
+// player gets the same share of the rollouts, played to the end of the game, and the move with the
+// best mean terminal value is played: the final score difference unless -objective asks for
+// another one. The player plays random moves in the rollouts, the opponent follows a rollout
+// policy, which is in the name of the strategy: "flatmc" against a random opponent,
+// "flatmc-greedy", "flatmc-seeder"... against the others.
+
+// RolloutPolicy chooses the move of PLAYER in s
+type RolloutPolicy func(s State, rng *rand.Rand) Move
+
+var rolloutPolicies = map[string]RolloutPolicy{
+	"random": func(s State, rng *rand.Rand) Move {
+		moves := s.GetSearchMoves(PLAYER)
+		return moves[rng.Intn(len(moves))]
+	},
+	"greedy":    func(s State, rng *rand.Rand) Move { return GreedyStrategy{}.Choose(s, Budget{}) },
+	"seeder":    func(s State, rng *rand.Rand) Move { return ChooseSeeder(s) },
+	"hoarder":   func(s State, rng *rand.Rand) Move { return ChooseHoarder(s) },
+	"harvester": func(s State, rng *rand.Rand) Move { return ChooseHarvester(s) },
+	"shadow":    func(s State, rng *rand.Rand) Move { return ChooseShadowAggressor(s) },
+}
+
+type FlatMCStrategy struct {
+	name     string
+	rng      *rand.Rand
+	opponent RolloutPolicy
+}
+
+func (fm *FlatMCStrategy) Name() string {
+	return fm.name
+}
+
+// Rollout plays the game to the end after the first move of the player
+// and returns its terminal value
+func (fm *FlatMCStrategy) Rollout(s State, firstMove Move) float64 {
+	playerMove := firstMove
+	for s.day < rules.NbDays {
+		s = s.Play(playerMove, fm.opponent(s.Swap(), fm.rng))
+		playerMoves := s.GetSearchMoves(PLAYER)
+		playerMove = playerMoves[fm.rng.Intn(len(playerMoves))]
+	}
+	return GetTerminalValue(s)
+}
+
+func (fm *FlatMCStrategy) Choose(s State, budget Budget) Move {
+	moves := s.GetLegalMoves(PLAYER)
+	if len(moves) == 1 {
+		return moves[0]
+	}
+	scores := make([]float64, len(moves))
+	visits := make([]int, len(moves))
+
+	t0 := time.Now()
+	for i := 0; !budget.IsSpent(t0, i); i++ {
+		k := i % len(moves)
+		scores[k] += fm.Rollout(s, moves[k])
+		visits[k]++
+	}
+
+	bestIndex := 0
+	for k := range moves {
+		if visits[k] > 0 && (visits[bestIndex] == 0 || scores[k]/float64(visits[k]) > scores[bestIndex]/float64(visits[bestIndex])) {
+			bestIndex = k
+		}
+	}
+	return moves[bestIndex]
+}
+
+func init() {
+	for policyName, policy := range rolloutPolicies {
+		name := "flatmc-" + policyName
+		if policyName == "random" {
+			name = "flatmc"
+		}
+		opponent := policy
+		RegisterStrategy(name, func(seed int64) Strategy {
+			return &FlatMCStrategy{name: name, rng: rand.New(rand.NewSource(seed)), opponent: opponent}
+		})
+	}
+}
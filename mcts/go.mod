@@ -0,0 +1,3 @@
+module mcts
+
+go 1.21
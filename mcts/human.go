@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*				HUMAN VS BOT					*/
+/*												*/
+/************************************************/
+
+// humanPlayer asks its moves on the terminal
+type humanPlayer struct {
+	in    *bufio.Scanner
+	out   io.Writer
+	color bool
+}
+
+func (h *humanPlayer) GetMove(s State, playerCode int, timeout time.Duration) Move {
+	view := s.GetView(playerCode)
+	fmt.Fprintln(h.out)
+	RenderBoard(h.out, view, h.color)
+	if view.isWaiting[OPPONENT] == 1 {
+		fmt.Fprintln(h.out, "the bot is waiting for the next day")
+	}
+
+	moves := view.GetLegalMoves(PLAYER)
+	for i, m := range moves {
+		fmt.Fprintf(h.out, "%3d  %s\n", i, m)
+	}
+	for {
+		fmt.Fprint(h.out, "your move (number or action): ")
+		if !h.in.Scan() {
+			fmt.Fprintln(h.out)
+			return Move{code: WAIT}
+		}
+		m, err := ParseMoveChoice(view, h.in.Text(), PLAYER)
+		if err == nil {
+			return m
+		}
+		fmt.Fprintln(h.out, "error:", err)
+	}
+}
+
+func (h *humanPlayer) Close() {}
+
+// runPlay lets a human play a whole game against a bot on the terminal, the game is saved as a replay
+func runPlay(args []string) {
+	flags := flag.NewFlagSet("play", flag.ExitOnError)
+	botName := flags.String("bot", "mcts", "strategy of the bot: "+strings.Join(GetStrategyNames(), ", "))
+	seed := flags.Int64("seed", time.Now().UnixNano(), "seed of the map generator and of the bot")
+	searchTime := flags.Duration("time", 100*time.Millisecond, "search time of the bot per turn")
+	replayPath := flags.String("replay", "", "file the game is saved to (play-<seed>.jsonl by default)")
+	color := flags.Bool("color", false, "use ANSI colors when drawing the board")
+	radius := flags.Int("radius", DEFAULT_BOARD_RADIUS, fmt.Sprintf("radius of the generated boards (%d to %d)", MIN_BOARD_RADIUS, MAX_BOARD_RADIUS))
+	gameFlags := addGameFlags(flags)
+	flags.Parse(args)
+
+	loadGameFlags(gameFlags)
+	if err := CheckBoardRadius(*radius); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *replayPath == "" {
+		*replayPath = fmt.Sprintf("play-%d.jsonl", *seed)
+	}
+	f, err := os.Create(*replayPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	s := NewGame(rand.New(rand.NewSource(*seed)), *radius)
+	bot, err := newPlayer(*botName, *seed, Budget{duration: *searchTime}, nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	defer bot.Close()
+
+	human := &humanPlayer{in: bufio.NewScanner(os.Stdin), out: os.Stdout, color: *color}
+	// the human is never timed out, the bot keeps the usual time limits
+	final := RunMatch([2]player{PLAYER: human, OPPONENT: bot}, s, NewReplayWriter(f))
+
+	// equal scores are decided by the number of trees
+	score := final.GetFinalScores()
+	fmt.Println()
+	switch final.GetWinner() {
+	case PLAYER:
+		fmt.Printf("you win %d to %d\n", score[PLAYER], score[OPPONENT])
+	case OPPONENT:
+		fmt.Printf("the bot wins %d to %d\n", score[OPPONENT], score[PLAYER])
+	default:
+		fmt.Printf("draw %d to %d\n", score[PLAYER], score[OPPONENT])
+	}
+	fmt.Println("replay saved to", *replayPath)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*				MACRO ACTIONS					*/
+/*												*/
+/************************************************/
+
+// In the macro mode, an edge of the tree is a whole day: each player picks a plan, the actions it
+// plays until it waits, and the child is the state of the next day once both plans are played.
+// The tree is then as deep as the number of days left. The plans of a player come from a small set
+// of greedy orders over the actions, with the plans of a single action.
+// The root of a search in the middle of a day plans the rest of the day, the bot plays the first
+// action of its best plan and plans again on its next turn.
+// The "mcts-macro" strategy searches with macro actions.
+
+const (
+	// a plan stops after this many actions, then waits
+	MACRO_MAX_ACTIONS = 6
+
+	// the plans of a single seed are those of the most promising seeds
+	MACRO_SINGLE_SEEDS = 2
+)
+
+// macroPlanOrders are the orders of the greedy plans: the plan plays the best move of the first
+// action of the order it can, until it can play none of them
+var macroPlanOrders = [][]int{
+	{COMPLETE, GROW, SEED},
+	{GROW, COMPLETE, SEED},
+	{GROW, SEED},
+	{SEED, GROW},
+	{COMPLETE},
+	{GROW},
+	{SEED},
+}
+
+// PlayAlone plays the move of playerCode while the other player waits
+func (s State) PlayAlone(m Move, playerCode int) State {
+	if playerCode == PLAYER {
+		return s.Play(m, Move{code: WAIT})
+	}
+	return s.Play(Move{code: WAIT}, m)
+}
+
+// GetMoveRank returns how good a greedy plan finds the move among those of the same action:
+// the richest cells for COMPLETE, the biggest trees for GROW, the best prior for SEED
+func (s State) GetMoveRank(m Move, playerCode int) float64 {
+	switch m.code {
+	case COMPLETE:
+		return float64(richnessMap[m.treeIndex])
+	case GROW:
+		return float64(10*s.treeMap[m.treeIndex] + richnessMap[m.treeIndex])
+	case SEED:
+		return s.GetSeedPrior(m, playerCode)
+	}
+	return 0
+}
+
+// GetGreedyPlan returns the plan of playerCode that follows the order of actions
+func (s State) GetGreedyPlan(order []int, playerCode int) []Move {
+	plan := []Move{}
+	for len(plan) < MACRO_MAX_ACTIONS {
+		moves := s.GetSearchMoves(playerCode)
+		found := false
+		bestMove, bestRank := Move{}, 0.0
+		for _, code := range order {
+			for _, m := range moves {
+				if m.code != code {
+					continue
+				}
+				if rank := s.GetMoveRank(m, playerCode); !found || rank > bestRank {
+					bestMove, bestRank, found = m, rank, true
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			break
+		}
+		plan = append(plan, bestMove)
+		s = s.PlayAlone(bestMove, playerCode)
+	}
+	return plan
+}
+
+// GetDayPlans returns the plans of playerCode for the rest of the day, without duplicates.
+// The first plan is the empty one, which waits at once.
+func (s State) GetDayPlans(playerCode int) [][]Move {
+	plans := [][]Move{{}}
+	if s.isWaiting[playerCode] == 1 || s.day >= rules.NbDays {
+		return plans
+	}
+
+	keys := map[string]bool{"": true}
+	addPlan := func(plan []Move) {
+		names := make([]string, len(plan))
+		for i, m := range plan {
+			names[i] = m.String()
+		}
+		key := strings.Join(names, ";")
+		if !keys[key] {
+			keys[key] = true
+			plans = append(plans, plan)
+		}
+	}
+
+	for _, order := range macroPlanOrders {
+		addPlan(s.GetGreedyPlan(order, playerCode))
+	}
+	moves := s.GetSearchMoves(playerCode)
+	firstSeed := s.SortSeeds(moves, playerCode)
+	for i, m := range moves {
+		if m.code != WAIT && i < firstSeed+MACRO_SINGLE_SEEDS {
+			addPlan([]Move{m})
+		}
+	}
+	return plans
+}
+
+// PlayDay plays the plans of both players until the end of the day. The actions a plan can no
+// longer play, after those of the other player, are skipped and a finished plan waits.
+func (s State) PlayDay(playerPlan []Move, opponentPlan []Move) State {
+	plans := [2][]Move{PLAYER: playerPlan, OPPONENT: opponentPlan}
+	next := [2]int{}
+	day := s.day
+	for s.day == day {
+		moves := [2]Move{{code: WAIT}, {code: WAIT}}
+		for playerCode, plan := range plans {
+			for s.isWaiting[playerCode] == 0 && next[playerCode] < len(plan) {
+				m := plan[next[playerCode]]
+				next[playerCode]++
+				if s.IsLegal(m, playerCode) {
+					moves[playerCode] = m
+					break
+				}
+			}
+		}
+		s = s.Play(moves[PLAYER], moves[OPPONENT])
+	}
+	return s
+}
+
+// GetFirstMoves returns the first action of each plan, WAIT for the empty plan
+func GetFirstMoves(plans [][]Move) []Move {
+	moves := make([]Move, len(plans))
+	for i, plan := range plans {
+		moves[i] = Move{code: WAIT}
+		if len(plan) > 0 {
+			moves[i] = plan[0]
+		}
+	}
+	return moves
+}
+
+// newMacroNode returns a node whose moves are the first actions of the day plans of both players
+func newMacroNode(s State, parentNode *Node) *Node {
+	plans := [2][][]Move{
+		PLAYER:   s.GetDayPlans(PLAYER),
+		OPPONENT: s.GetDayPlans(OPPONENT),
+	}
+	n := newNodeWithMoves(s, parentNode, GetFirstMoves(plans[PLAYER]), GetFirstMoves(plans[OPPONENT]))
+	n.plans = plans
+	n.firstSeed = [2]int{PLAYER: len(plans[PLAYER]), OPPONENT: len(plans[OPPONENT])}
+	return n
+}
+
+// IsMacro returns true if the edges of the node are day plans
+func (n *Node) IsMacro() bool {
+	return n.plans[PLAYER] != nil
+}
+
+// newMacroBot returns a bot whose search tree has one edge per day
+func newMacroBot(seed int64) *Bot {
+	b := newBot(seed)
+	b.name = "mcts-macro"
+	b.gameTree.macro = true
+	return b
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"math/rand"
+)
+
+/************************************************/
+/*												*/
+/*				OPPONENT MODEL					*/
+/*												*/
+/************************************************/
+
+// The opponent model learns the habits of the opponent during the match from the actions the
+// OpponentTracker works out: how often it plays each action in each phase of the game and how far
+// it throws its seeds. It gives a prior over the opponent moves of a state, used by the search to
+// pick the opponent moves of the tree first and to play the opponent in the rollouts.
+// The "mcts-model" strategy is the MCTS bot with the model, "mcts" plays without it.
+
+const (
+	// the game is cut in this many phases, the action frequencies are learned for each one
+	MODEL_PHASES = 4
+
+	// weight, in actions, of the frequencies a phase falls back on before the opponent played in it
+	MODEL_PRIOR_COUNT = 2.0
+
+	// weight of the prior in the opponent move selection, it fades as the moves get visited
+	MODEL_BIAS = 1.0
+)
+
+type OpponentModel struct {
+	codeCounts [MODEL_PHASES][4]float64
+
+	// seedDistanceCounts[d] counts the seeds thrown d cells away
+	seedDistanceCounts [4]float64
+}
+
+func newOpponentModel() *OpponentModel {
+	return &OpponentModel{}
+}
+
+func GetPhase(day int) int {
+	return min(day*MODEL_PHASES/max(rules.NbDays, 1), MODEL_PHASES-1)
+}
+
+// Learn adds the actions of the opponent to the model
+func (om *OpponentModel) Learn(actions []OpponentAction) {
+	for _, a := range actions {
+		om.codeCounts[GetPhase(a.day)][a.move.code]++
+		if a.move.code == SEED && a.move.treeIndex >= 0 && a.move.targetIndex >= 0 {
+			om.seedDistanceCounts[min(GetDistance(a.move.treeIndex, a.move.targetIndex), 3)]++
+		}
+	}
+}
+
+// smooth returns the frequencies of counts, pulled towards fallback by MODEL_PRIOR_COUNT actions
+func smooth(counts []float64, fallback []float64) []float64 {
+	total := 0.0
+	for _, c := range counts {
+		total += c
+	}
+	frequencies := make([]float64, len(counts))
+	for i, c := range counts {
+		frequencies[i] = (c + MODEL_PRIOR_COUNT*fallback[i]) / (total + MODEL_PRIOR_COUNT)
+	}
+	return frequencies
+}
+
+// GetCodeFrequencies returns how often the opponent plays each action on day, indexed by action code:
+// the frequencies of the phase of day, falling back on those of the whole match, then on the allowed actions
+func (om *OpponentModel) GetCodeFrequencies(day int) []float64 {
+	uniform := make([]float64, 4)
+	nbAllowed := 0
+	for _, allowed := range rules.AllowedActions {
+		if allowed {
+			nbAllowed++
+		}
+	}
+	for code, allowed := range rules.AllowedActions {
+		if allowed {
+			uniform[code] = 1 / float64(nbAllowed)
+		}
+	}
+
+	overall := make([]float64, 4)
+	for _, counts := range om.codeCounts {
+		for code, c := range counts {
+			overall[code] += c
+		}
+	}
+	return smooth(om.codeCounts[GetPhase(day)][:], smooth(overall, uniform))
+}
+
+// GetSeedDistanceFrequencies returns how often the opponent throws its seeds 1, 2 or 3 cells away
+func (om *OpponentModel) GetSeedDistanceFrequencies() []float64 {
+	return smooth(om.seedDistanceCounts[:], []float64{0, 1.0 / 3, 1.0 / 3, 1.0 / 3})
+}
+
+// GetPrior returns the probability the opponent plays each of its moves in s: the frequency of the
+// action is shared between the moves of that action, by seed distance for the seeds
+func (om *OpponentModel) GetPrior(s State, moves []Move) []float64 {
+	codeFrequencies := om.GetCodeFrequencies(s.day)
+	distanceFrequencies := om.GetSeedDistanceFrequencies()
+
+	codeWeights := [4]float64{}
+	weights := make([]float64, len(moves))
+	for i, m := range moves {
+		weights[i] = 1
+		if m.code == SEED {
+			weights[i] = distanceFrequencies[min(GetDistance(m.treeIndex, m.targetIndex), 3)]
+		}
+		codeWeights[m.code] += weights[i]
+	}
+
+	total := 0.0
+	for i, m := range moves {
+		weights[i] *= codeFrequencies[m.code] / codeWeights[m.code]
+		total += weights[i]
+	}
+	for i := range weights {
+		if total > 0 {
+			weights[i] /= total
+		} else {
+			weights[i] = 1 / float64(len(weights))
+		}
+	}
+	return weights
+}
+
+// SampleIndex returns an index drawn with the probabilities of prior
+func SampleIndex(prior []float64, rng *rand.Rand) int {
+	x := rng.Float64()
+	for i, p := range prior {
+		x -= p
+		if x < 0 {
+			return i
+		}
+	}
+	return len(prior) - 1
+}
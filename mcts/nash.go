@@ -0,0 +1,219 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*				NASH EQUILIBRIUM				*/
+/*												*/
+/************************************************/
+
+// Both players move at once, so a turn is a matrix game: the rows are our moves, the columns
+// those of the opponent and the payoff of a cell is the value for us of the state they lead to.
+// With depth 1 that value is Evaluate, with depth 2 the value of the matrix game of the child,
+// itself of depth 1. The mixed equilibrium of the game is worked out by fictitious play: each
+// player in turn plays its best reply to the moves the other one played so far, their
+// frequencies get closer to an equilibrium as the plays go.
+// The "nash" and "nash2" strategies sample their move from the equilibrium of depth 1 and 2,
+// "nash2" falls back on depth 1 when the budget is spent before its matrix is known, and both play
+// the move of the best complete row, or the fallback move, when the deadline comes first. The
+// iterations of their budget are payoff evaluations, which keeps the fallback the same from one run
+// to the next.
+
+const (
+	NASH_ITERATIONS = 1000
+
+	// the moves under this probability, left by the first plays of fictitious play, are never sampled
+	NASH_MIN_PROBABILITY = 0.02
+)
+
+// SolveMatrixGame returns the mixed strategies of the row player, who maximises the payoff, and of
+// the column player, who minimises it, after iterations plays of fictitious play, and the value of the game
+func SolveMatrixGame(payoff [][]float64, iterations int) ([]float64, []float64, float64) {
+	rowCounts, colCounts := make([]float64, len(payoff)), make([]float64, len(payoff[0]))
+
+	// rowPayoffs[i] is the total payoff of row i against the columns played so far, colPayoffs[j] likewise
+	rowPayoffs, colPayoffs := make([]float64, len(payoff)), make([]float64, len(payoff[0]))
+	row, col := 0, 0
+	for t := 0; t < iterations; t++ {
+		rowCounts[row]++
+		colCounts[col]++
+		for i := range rowPayoffs {
+			rowPayoffs[i] += payoff[i][col]
+		}
+		for j := range colPayoffs {
+			colPayoffs[j] += payoff[row][j]
+		}
+		for i := range rowPayoffs {
+			if rowPayoffs[i] > rowPayoffs[row] {
+				row = i
+			}
+		}
+		for j := range colPayoffs {
+			if colPayoffs[j] < colPayoffs[col] {
+				col = j
+			}
+		}
+	}
+
+	value := 0.0
+	for i := range rowCounts {
+		rowCounts[i] /= float64(iterations)
+	}
+	for j := range colCounts {
+		colCounts[j] /= float64(iterations)
+	}
+	for i := range payoff {
+		for j := range payoff[i] {
+			value += rowCounts[i] * colCounts[j] * payoff[i][j]
+		}
+	}
+	return rowCounts, colCounts, value
+}
+
+// GetNashValue returns the value of s for PLAYER in the matrix game of depth moves, false if
+// isSpent stopped it
+func (s State) GetNashValue(depth int, isSpent func() bool) (float64, bool) {
+	if depth == 0 || s.day >= rules.NbDays {
+		return Evaluate(s), true
+	}
+	playerMoves, opponentMoves := s.GetSearchMoves(PLAYER), s.GetSearchMoves(OPPONENT)
+	payoff := make([][]float64, len(playerMoves))
+	for i, playerMove := range playerMoves {
+		payoff[i] = make([]float64, len(opponentMoves))
+		for j, opponentMove := range opponentMoves {
+			if isSpent() {
+				return 0, false
+			}
+			value, ok := s.Play(playerMove, opponentMove).GetNashValue(depth-1, isSpent)
+			if !ok {
+				return 0, false
+			}
+			payoff[i][j] = value
+		}
+	}
+	_, _, value := SolveMatrixGame(payoff, NASH_ITERATIONS)
+	return value, true
+}
+
+// GetPayoffMatrix returns the payoffs of the moves of the root, the values of its children
+// in the matrix games of depth-1 moves, row after row. If isSpent stops it, it returns the rows
+// that were complete and false.
+func (n *Node) GetPayoffMatrix(depth int, isSpent func() bool) ([][]float64, bool) {
+	payoff := make([][]float64, len(n.playerMoveList))
+	for i := range n.playerMoveList {
+		payoff[i] = make([]float64, len(n.opponentMoveList))
+		for j := range n.opponentMoveList {
+			if isSpent() {
+				return payoff[:i], false
+			}
+			value, ok := n.GetChild(i, j).state.GetNashValue(depth-1, isSpent)
+			if !ok {
+				return payoff[:i], false
+			}
+			payoff[i][j] = value
+		}
+	}
+	return payoff, true
+}
+
+// GetBestRow returns the row whose worst payoff is the best
+func GetBestRow(payoff [][]float64) int {
+	bestRow, bestValue := 0, math.Inf(-1)
+	for i, row := range payoff {
+		worst := math.Inf(1)
+		for _, value := range row {
+			worst = math.Min(worst, value)
+		}
+		if worst > bestValue {
+			bestRow, bestValue = i, worst
+		}
+	}
+	return bestRow
+}
+
+// SampleEquilibrium draws an index from the mixed strategy, without its moves under NASH_MIN_PROBABILITY
+func SampleEquilibrium(strategy []float64, rng *rand.Rand) int {
+	weights := make([]float64, len(strategy))
+	total := 0.0
+	for i, p := range strategy {
+		if p >= NASH_MIN_PROBABILITY {
+			weights[i] = p
+			total += p
+		}
+	}
+	if total == 0 {
+		return SampleIndex(strategy, rng)
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return SampleIndex(weights, rng)
+}
+
+type NashStrategy struct {
+	name  string
+	depth int
+	rng   *rand.Rand
+}
+
+func (ns *NashStrategy) Name() string {
+	return ns.name
+}
+
+func (ns *NashStrategy) Choose(s State, budget Budget) Move {
+	root := newNode(s, nil)
+	if len(root.playerMoveList) == 1 {
+		return root.playerMoveList[0]
+	}
+	// isSpent is called before every payoff evaluation
+	t0 := time.Now()
+	nbEvaluations := 0
+	isSpent := func() bool {
+		nbEvaluations++
+		return budget.IsSpent(t0, nbEvaluations)
+	}
+
+	payoff, ok := root.GetPayoffMatrix(ns.depth, isSpent)
+	if !ok && ns.depth > 1 {
+		// only the deadline stops the matrix of depth 1, it stays the same under a number of iterations
+		payoff, ok = root.GetPayoffMatrix(1, func() bool {
+			return !budget.deadline.IsZero() && time.Now().After(budget.deadline)
+		})
+	}
+	if !ok {
+		if len(payoff) == 0 {
+			return FallbackMove(s)
+		}
+		return root.playerMoveList[GetBestRow(payoff)]
+	}
+	strategy, _, _ := SolveMatrixGame(payoff, NASH_ITERATIONS)
+	return root.playerMoveList[SampleEquilibrium(strategy, ns.rng)]
+}
+
+// GetSupport returns the indexes of the moves of the mixed strategy played at least with
+// NASH_MIN_PROBABILITY, the most likely first
+func GetSupport(strategy []float64) []int {
+	support := []int{}
+	for i, p := range strategy {
+		if p >= NASH_MIN_PROBABILITY {
+			support = append(support, i)
+		}
+	}
+	sort.SliceStable(support, func(i, j int) bool { return strategy[support[i]] > strategy[support[j]] })
+	return support
+}
+
+func init() {
+	for name, depth := range map[string]int{"nash": 1, "nash2": 2} {
+		nashDepth, nashName := depth, name
+		RegisterStrategy(name, func(seed int64) Strategy {
+			return &NashStrategy{name: nashName, depth: nashDepth, rng: rand.New(rand.NewSource(seed))}
+		})
+	}
+}
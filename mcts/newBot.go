@@ -2,9 +2,15 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"math"
+	"math/rand"
 	"os"
 	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -21,9 +27,17 @@ const (
 	SW   = 4
 	SE   = 5
 
-	FINAL_DAY = 24
+	// the root is expanded this deep before searching, deeper nodes are created by the search
+	KNOWN_DEPTH = 1
 
-	KNOWN_DEPTH = 2
+	// exploration constant of UCB1, rewards are between 0 and 1
+	EXPLORATION = 0.7
+
+	// number of iterations between two updates of the best move read by the watchdog
+	PUBLISH_PERIOD = 64
+
+	// number of days at the end of the game during which the fallback move completes trees
+	FALLBACK_COMPLETE_DAYS = 5
 
 	PLAYER   = 1
 	OPPONENT = 0
@@ -35,11 +49,15 @@ const (
 /*												*/
 /************************************************/
 
+// nbCells is the number of cells of the loaded board, the arrays indexed by cell are sized
+// for the biggest board and only their first nbCells values are used
+var nbCells int
+
 // richnessMap stores to fertility value (0,2 or 4)of each cell (-1 if unfertile)
-var richnessMap [37]int
+var richnessMap [MAX_CELLS]int
 
 // neighboursMap stores the indexes of every adjacent cells for each cell (-1 if out of bounds)
-var neighboursMap [37][6]int
+var neighboursMap [MAX_CELLS][6]int
 
 type State struct {
 	day       int
@@ -50,10 +68,10 @@ type State struct {
 	score [2]int
 
 	// an array storing the size (0-3) of every tree on every cell (-1 = no tree)
-	treeMap [37]int
+	treeMap [MAX_CELLS]int
 
 	// an array storing the shadows of every tree by size for every cell (1 if shadowed, 0 if not)
-	shadowMap [3][37]int
+	shadowMap [3][MAX_CELLS]int
 
 	// first array index indicates wich player has the trees
 	// second array index indicates the size of the trees
@@ -78,7 +96,7 @@ type Move struct {
 
 func newState() State {
 
-	treeMap := [37]int{}
+	treeMap := [MAX_CELLS]int{}
 	for i := 0; i < len(treeMap); i++ {
 		treeMap[i] = -1
 	}
@@ -89,17 +107,18 @@ func newState() State {
 		sun:               [2]int{},
 		score:             [2]int{},
 		treeMap:           treeMap,
-		shadowMap:         [3][37]int{},
+		shadowMap:         [3][MAX_CELLS]int{},
 		nbTrees:           [2][4]int{},
 		activeTreesIndex:  [2][]int{},
 		dormantTreesIndex: [2][]int{},
 		isWaiting:         [2]int{},
-		growCost:          [2][3]int{{1, 3, 7}, {1, 3, 7}},
+		growCost:          [2][3]int{rules.GrowBaseCost, rules.GrowBaseCost},
 	}
 	return s
 }
 
-func getData(scanner *bufio.Scanner) State {
+// getData reads the input of one turn, it returns false once the input is over
+func getData(scanner *bufio.Scanner) (State, bool) {
 
 	// numberOfTrees: the current amount of trees
 	var numberOfTrees int
@@ -115,7 +134,9 @@ func getData(scanner *bufio.Scanner) State {
 
 	s := newState()
 
-	scanner.Scan()
+	if !scanner.Scan() {
+		return s, false
+	}
 	fmt.Sscan(scanner.Text(), &s.day)
 
 	scanner.Scan()
@@ -132,22 +153,10 @@ func getData(scanner *bufio.Scanner) State {
 	for i := 0; i < numberOfTrees; i++ {
 		scanner.Scan()
 		fmt.Sscan(scanner.Text(), &cellIndex, &size, &isMine, &isDormant)
-
-		s.treeMap[cellIndex] = size
-		s.nbTrees[isMine][size]++
-		switch isDormant {
-		case 0:
-			s.activeTreesIndex[isMine] = append(s.activeTreesIndex[isMine], cellIndex)
-		default:
-			s.dormantTreesIndex[isMine] = append(s.dormantTreesIndex[isMine], cellIndex)
-		}
-	}
-
-	for i := 0; i < 3; i++ {
-		s.growCost[PLAYER][i] += s.nbTrees[PLAYER][i+1]
-		s.growCost[OPPONENT][i] += s.nbTrees[OPPONENT][i+1]
+		s = s.AddTree(cellIndex, size, isMine, isDormant == 1)
 	}
 
+	s = s.UpdateGrowCosts()
 	s = s.UpdateShadows()
 
 	/////// 		don't know how to get rid of this
@@ -164,23 +173,126 @@ func getData(scanner *bufio.Scanner) State {
 	///////
 	/////// 		don't know how to get rid of this
 
+	return s, true
+}
+
+// AddTree places a tree of size on cellIndex for owner (PLAYER or OPPONENT),
+// UpdateGrowCosts and UpdateShadows must be called once every tree is placed
+func (s State) AddTree(cellIndex int, size int, owner int, isDormant bool) State {
+	s.treeMap[cellIndex] = size
+	s.nbTrees[owner][size]++
+	switch isDormant {
+	case false:
+		s.activeTreesIndex[owner] = append(s.activeTreesIndex[owner], cellIndex)
+	default:
+		s.dormantTreesIndex[owner] = append(s.dormantTreesIndex[owner], cellIndex)
+	}
 	return s
 }
 
-func (m Move) Print() {
+// UpdateGrowCosts computes the grow costs of both players from the number of trees they own
+func (s State) UpdateGrowCosts() State {
+	s.growCost = [2][3]int{rules.GrowBaseCost, rules.GrowBaseCost}
+	for i := 0; i < 3; i++ {
+		s.growCost[PLAYER][i] += s.nbTrees[PLAYER][i+1]
+		s.growCost[OPPONENT][i] += s.nbTrees[OPPONENT][i+1]
+	}
+	return s
+}
+
+// GetOwner returns the owner (PLAYER or OPPONENT) of the tree on cell, -1 if there is none
+func (s State) GetOwner(cell int) int {
+	for i := 0; i < 2; i++ {
+		for _, treeIndex := range s.activeTreesIndex[i] {
+			if treeIndex == cell {
+				return i
+			}
+		}
+		for _, treeIndex := range s.dormantTreesIndex[i] {
+			if treeIndex == cell {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// IsDormant returns true if the tree on cell is dormant
+func (s State) IsDormant(cell int) bool {
+	for i := 0; i < 2; i++ {
+		for _, treeIndex := range s.dormantTreesIndex[i] {
+			if treeIndex == cell {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetSunIncome returns the sun points each player collects from its trees at the start of the day
+func (s State) GetSunIncome() [2]int {
+	sunPoints := s.GetSunPoints()
+	return [2]int{sunPoints[0] - s.sun[0], sunPoints[1] - s.sun[1]}
+}
+
+// GetFinalScores returns the scores of both players if the game ended now
+func (s State) GetFinalScores() [2]int {
+	return [2]int{s.score[0] + s.sun[0]/3, s.score[1] + s.sun[1]/3}
+}
+
+func (m Move) String() string {
 	switch m.code {
 	case SEED:
-		fmt.Println("SEED ", m.treeIndex, " ", m.targetIndex)
+		return fmt.Sprintf("SEED %d %d", m.treeIndex, m.targetIndex)
 	case GROW:
-		fmt.Println("GROW ", m.treeIndex)
+		return fmt.Sprintf("GROW %d", m.treeIndex)
 	case COMPLETE:
-		fmt.Println("GROW ", m.treeIndex)
-	case WAIT:
-		fmt.Println("WAIT")
+		return fmt.Sprintf("COMPLETE %d", m.treeIndex)
+	}
+	return "WAIT"
+}
+
+func (m Move) Print() {
+	fmt.Println(m.String())
+}
+
+// ParseMove reads a move written the way the game expects it ("SEED 1 7", "GROW 3", "WAIT"...),
+// anything after the expected fields is ignored
+func ParseMove(str string) (Move, error) {
+	fields := strings.Fields(str)
+	if len(fields) == 0 {
+		return Move{}, fmt.Errorf("empty move")
+	}
+	m := Move{}
+	nbArgs := 0
+	switch fields[0] {
+	case "SEED":
+		m.code, nbArgs = SEED, 2
+	case "GROW":
+		m.code, nbArgs = GROW, 1
+	case "COMPLETE":
+		m.code, nbArgs = COMPLETE, 1
+	case "WAIT":
+		m.code = WAIT
+	default:
+		return Move{}, fmt.Errorf("unknown action %q", fields[0])
+	}
+	if len(fields) < nbArgs+1 {
+		return Move{}, fmt.Errorf("missing arguments in %q", str)
 	}
+	args := [2]int{}
+	for i := 0; i < nbArgs; i++ {
+		arg, err := strconv.Atoi(fields[i+1])
+		if err != nil || arg < 0 || arg >= nbCells {
+			return Move{}, fmt.Errorf("bad cell index %q in %q", fields[i+1], str)
+		}
+		args[i] = arg
+	}
+	m.treeIndex, m.targetIndex = args[0], args[1]
+	return m, nil
 }
 
-func UpdateOneShadow(shadowMap *[3][37]int, m Move, s State) {
+func UpdateOneShadow(shadowMap *[3][MAX_CELLS]int, m Move, s State) {
 	sunDirection := s.day % 6
 	nextCell := neighboursMap[m.treeIndex][sunDirection]
 	size := s.treeMap[m.treeIndex]
@@ -208,9 +320,9 @@ func UpdateOneShadow(shadowMap *[3][37]int, m Move, s State) {
 
 func (s State) UpdateShadows() State {
 	sunDirection := s.day % 6
-	s.shadowMap = [3][37]int{}
-	for i, size := range s.treeMap {
-		if size > 0 {
+	s.shadowMap = [3][MAX_CELLS]int{}
+	for i := 0; i < nbCells; i++ {
+		if size := s.treeMap[i]; size > 0 {
 			nextCell := neighboursMap[i][sunDirection]
 			for j := 0; j < size; j++ {
 				if nextCell < 0 {
@@ -245,7 +357,7 @@ func (s State) GetSunPoints() [2]int {
 }
 
 func (s State) GetFreeCellsInRange(startingCell int, radius int) []int {
-	freeCells := [37]int{}
+	freeCells := [MAX_CELLS]int{}
 	toDoCells := []int{startingCell}
 	for radius > 0 {
 		nextToDoCells := []int{}
@@ -262,7 +374,7 @@ func (s State) GetFreeCellsInRange(startingCell int, radius int) []int {
 	}
 	freeCells[startingCell] = 0
 	doneCells := []int{}
-	for i, cell := range freeCells {
+	for i, cell := range freeCells[:nbCells] {
 		if cell > 0 {
 			doneCells = append(doneCells, i)
 		}
@@ -270,6 +382,11 @@ func (s State) GetFreeCellsInRange(startingCell int, radius int) []int {
 	return doneCells
 }
 
+// GetSeedCost returns the sun playerCode pays for its next seed
+func (s State) GetSeedCost(playerCode int) int {
+	return rules.SeedBaseCost + s.nbTrees[playerCode][0]
+}
+
 func (s State) GetLegalMoves(playerCode int) []Move {
 
 	moves := []Move{Move{code: WAIT}}
@@ -277,28 +394,29 @@ func (s State) GetLegalMoves(playerCode int) []Move {
 		return moves
 	}
 
+	canSeed := rules.AllowedActions[SEED] && s.sun[playerCode] >= s.GetSeedCost(playerCode)
 	for _, treeIndex := range s.activeTreesIndex[playerCode] {
 		size := s.treeMap[treeIndex]
 		switch size {
 		case 0:
-			if s.sun[playerCode] >= s.growCost[playerCode][0] {
+			if rules.AllowedActions[GROW] && s.sun[playerCode] >= s.growCost[playerCode][0] {
 				moves = append(moves, Move{code: GROW, treeIndex: treeIndex})
 			}
 		case 3:
-			if s.sun[playerCode] >= 4 {
+			if rules.AllowedActions[COMPLETE] && s.sun[playerCode] >= rules.CompleteCost {
 				moves = append(moves, Move{code: COMPLETE, treeIndex: treeIndex})
 			}
-			if s.sun[playerCode] >= s.nbTrees[playerCode][0] {
+			if canSeed {
 				indexes := s.GetFreeCellsInRange(treeIndex, size)
 				for _, index := range indexes {
 					moves = append(moves, Move{code: SEED, treeIndex: treeIndex, targetIndex: index})
 				}
 			}
 		default:
-			if s.sun[playerCode] >= s.growCost[playerCode][size] {
+			if rules.AllowedActions[GROW] && s.sun[playerCode] >= s.growCost[playerCode][size] {
 				moves = append(moves, Move{code: GROW, treeIndex: treeIndex})
 			}
-			if s.sun[playerCode] >= s.nbTrees[playerCode][0] {
+			if canSeed {
 				indexes := s.GetFreeCellsInRange(treeIndex, size)
 				for _, index := range indexes {
 					moves = append(moves, Move{code: SEED, treeIndex: treeIndex, targetIndex: index})
@@ -314,6 +432,10 @@ func (s State) IsEqual(st State) bool {
 	if !isEqual {
 		return false
 	}
+	if len(s.activeTreesIndex[PLAYER]) != len(st.activeTreesIndex[PLAYER]) ||
+		len(s.activeTreesIndex[OPPONENT]) != len(st.activeTreesIndex[OPPONENT]) {
+		return false
+	}
 	for i := 0; i < len(s.activeTreesIndex[PLAYER]); i++ {
 		if s.activeTreesIndex[PLAYER][i] != st.activeTreesIndex[PLAYER][i] {
 			return false
@@ -338,11 +460,13 @@ func RemoveFromSlice(s []int, element int) []int {
 	return s
 }
 
+// Seed throws the seed of m, a seed that fails because both players seeded the same cell
+// is not planted and costs nothing, but its tree still goes dormant
 func (s State) Seed(m Move, playerCode int, isSuccessful bool) State {
 	s.activeTreesIndex[playerCode] = RemoveFromSlice(s.activeTreesIndex[playerCode], m.treeIndex)
 	s.dormantTreesIndex[playerCode] = append(s.dormantTreesIndex[playerCode], m.treeIndex)
-	s.sun[playerCode] -= s.nbTrees[playerCode][0]
 	if isSuccessful {
+		s.sun[playerCode] -= s.GetSeedCost(playerCode)
 		s.dormantTreesIndex[playerCode] = append(s.dormantTreesIndex[playerCode], m.targetIndex)
 		s.treeMap[m.targetIndex] = 0
 		s.nbTrees[playerCode][0]++
@@ -373,10 +497,14 @@ func (s State) Grow(m Move, playerCode int) State {
 func (s State) Complete(m Move, playerCode int) State {
 	s.activeTreesIndex[playerCode] = RemoveFromSlice(s.activeTreesIndex[playerCode], m.treeIndex)
 	UpdateOneShadow(&s.shadowMap, m, s)
-	s.treeMap[m.treeIndex] = 0
-	s.score[playerCode] += s.nutrients
-	s.nutrients--
+	s.treeMap[m.treeIndex] = -1
+	s.sun[playerCode] -= rules.CompleteCost
+	s.score[playerCode] += s.nutrients + richnessMap[m.treeIndex]
+	if s.nutrients > 0 {
+		s.nutrients--
+	}
 	s.nbTrees[playerCode][3]--
+	s.growCost[playerCode][2]--
 	sort.Ints(s.activeTreesIndex[playerCode])
 	return s
 }
@@ -392,7 +520,9 @@ func (s State) PlaySpecialCases(playerMove Move, opponentMove Move) State {
 		s.dormantTreesIndex[OPPONENT] = []int{}
 		s.isWaiting[OPPONENT], s.isWaiting[PLAYER] = 0, 0
 		s = s.UpdateShadows()
-		s.sun = s.GetSunPoints()
+		if s.day < rules.NbDays {
+			s.sun = s.GetSunPoints()
+		}
 		sort.Ints(s.activeTreesIndex[PLAYER])
 		sort.Ints(s.activeTreesIndex[OPPONENT])
 	case SEED:
@@ -406,15 +536,33 @@ func (s State) PlaySpecialCases(playerMove Move, opponentMove Move) State {
 		s = s.Grow(playerMove, PLAYER)
 		s = s.Grow(opponentMove, OPPONENT)
 	case COMPLETE:
+		// both players get the same nutrients, which then drop twice
+		nutrients := s.nutrients
 		s = s.Complete(playerMove, PLAYER)
+		s.nutrients = nutrients
 		s = s.Complete(opponentMove, OPPONENT)
-		s.score[OPPONENT]++
+		s.nutrients = nutrients - 2
+		if s.nutrients < 0 {
+			s.nutrients = 0
+		}
+	}
+	return s
+}
+
+// CloneTrees gives the state its own copy of the tree index lists,
+// so that playing on a copy of a state never changes the original
+func (s State) CloneTrees() State {
+	for i := 0; i < 2; i++ {
+		s.activeTreesIndex[i] = append([]int{}, s.activeTreesIndex[i]...)
+		s.dormantTreesIndex[i] = append([]int{}, s.dormantTreesIndex[i]...)
 	}
 	return s
 }
 
 func (s State) Play(playerMove Move, opponentMove Move) State {
 
+	s = s.CloneTrees()
+
 	if playerMove.code == opponentMove.code {
 		return s.PlaySpecialCases(playerMove, opponentMove)
 	}
@@ -451,84 +599,338 @@ func (s State) Play(playerMove Move, opponentMove Move) State {
 
 type GameTree struct {
 	root *Node
+	rng  *rand.Rand
+
+	// stopped ends the search early, bestMove is the best move found so far,
+	// both can be used while the search runs in another goroutine
+	stopped  atomic.Bool
+	bestMove atomic.Pointer[Move]
+
+	// model biases the opponent moves of the search, nil for a uniform opponent
+	model *OpponentModel
+
+	// macro makes the edges of the tree day plans instead of single actions
+	macro bool
 }
 
+// Node stores the statistics of both players separately (decoupled UCT):
+// playerMoveScore[i] is the sum of the rewards of the player after playing playerMoveList[i]
+// and children[i*len(opponentMoveList)+j] is reached by playing playerMoveList[i] and opponentMoveList[j]
 type Node struct {
-	nbVisit           int
-	state             State
-	playerMoveList    []Move
-	opponentMoveList  []Move
-	playerMoveScore   []float64
-	opponentMoveScore []float64
-	parent            *Node
-	children          []*Node
+	nbVisit            int
+	state              State
+	playerMoveList     []Move
+	opponentMoveList   []Move
+	playerMoveScore    []float64
+	opponentMoveScore  []float64
+	playerMoveVisits   []int
+	opponentMoveVisits []int
+	parent             *Node
+	children           []*Node
+
+	// opponentPrior is the opponent model prior over opponentMoveList, computed on the first selection
+	opponentPrior []float64
+
+	// firstSeed[playerCode] is the index of the first seed in the move list of playerCode,
+	// the seeds are sorted for progressive widening
+	firstSeed [2]int
+
+	// plans[playerCode] are the day plans of a macro node, its moves are their first actions,
+	// nil for a node of single actions
+	plans [2][][]Move
+}
+
+// MoveStats is the result of the search for one move of the root
+type MoveStats struct {
+	move   Move
+	visits int
+	value  float64
+}
+
+// newGameTree returns an empty tree whose rollouts are drawn from seed
+func newGameTree(seed int64) *GameTree {
+	return &GameTree{rng: rand.New(rand.NewSource(seed))}
 }
 
 func newNode(s State, parentNode *Node) *Node {
+	playerMoveList := s.GetSearchMoves(PLAYER)
+	opponentMoveList := s.GetSearchMoves(OPPONENT)
+	n := newNodeWithMoves(s, parentNode, playerMoveList, opponentMoveList)
+	n.firstSeed = [2]int{
+		PLAYER:   s.SortSeeds(playerMoveList, PLAYER),
+		OPPONENT: s.SortSeeds(opponentMoveList, OPPONENT),
+	}
+	return n
+}
+
+func newNodeWithMoves(s State, parentNode *Node, playerMoveList []Move, opponentMoveList []Move) *Node {
 	return &Node{
-		nbVisit:           0,
-		state:             s,
-		playerMoveList:    s.GetLegalMoves(PLAYER),
-		opponentMoveList:  s.GetLegalMoves(OPPONENT),
-		playerMoveScore:   []float64{},
-		opponentMoveScore: []float64{},
-		parent:            parentNode,
-		children:          []*Node{},
+		nbVisit:            0,
+		state:              s,
+		playerMoveList:     playerMoveList,
+		opponentMoveList:   opponentMoveList,
+		playerMoveScore:    make([]float64, len(playerMoveList)),
+		opponentMoveScore:  make([]float64, len(opponentMoveList)),
+		playerMoveVisits:   make([]int, len(playerMoveList)),
+		opponentMoveVisits: make([]int, len(opponentMoveList)),
+		parent:             parentNode,
+		children:           []*Node{},
 	}
 }
 
-func (n *Node) GetAllChildrenNodes(depth int) {
+func (n *Node) IsTerminal() bool {
+	return n.state.day >= rules.NbDays
+}
 
-	if depth == 0 {
+// GetChild returns the child reached by the moves of index playerIndex and opponentIndex, creating it if needed
+func (n *Node) GetChild(playerIndex int, opponentIndex int) *Node {
+	if len(n.children) == 0 {
+		n.children = make([]*Node, len(n.playerMoveList)*len(n.opponentMoveList))
+	}
+	i := playerIndex*len(n.opponentMoveList) + opponentIndex
+	if n.children[i] == nil && n.IsMacro() {
+		nextState := n.state.PlayDay(n.plans[PLAYER][playerIndex], n.plans[OPPONENT][opponentIndex])
+		n.children[i] = newMacroNode(nextState, n)
+	}
+	if n.children[i] == nil {
+		nextState := n.state.Play(n.playerMoveList[playerIndex], n.opponentMoveList[opponentIndex])
+		n.children[i] = newNode(nextState, n)
+	}
+	return n.children[i]
+}
+
+func (n *Node) GetAllChildrenNodes(depth int, stopped *atomic.Bool) {
+
+	if depth == 0 || stopped.Load() {
 		return
 	}
 
-	if n.state.day == FINAL_DAY {
+	if n.IsTerminal() {
 		return
 	}
 
-	if len(n.children) == 0 {
-		for _, playerMove := range n.playerMoveList {
-			for _, opponentMove := range n.opponentMoveList {
-				nextState := n.state.Play(playerMove, opponentMove)
-				n.children = append(n.children, newNode(nextState, n))
-			}
+	for i := range n.playerMoveList {
+		for j := range n.opponentMoveList {
+			n.GetChild(i, j).GetAllChildrenNodes(depth-1, stopped)
+		}
+	}
+}
+
+// SelectMove returns the index of the move of playerCode with the best UCB1 value among the moves
+// progressive widening considers, moves that were never tried come first.
+// A prior over the moves, if not nil, gives the order of the untried moves and a bias that fades with the visits.
+func (n *Node) SelectMove(playerCode int, rng *rand.Rand, prior []float64) int {
+	scores, visits := n.playerMoveScore, n.playerMoveVisits
+	if playerCode == OPPONENT {
+		scores, visits = n.opponentMoveScore, n.opponentMoveVisits
+	}
+
+	// unvisited moves are tried in a random order: in the same order, both players of a
+	// symmetric position would only ever try mirrored moves
+	width := n.GetWidth(playerCode)
+	unvisited := []int{}
+	for i := range visits[:width] {
+		if visits[i] == 0 {
+			unvisited = append(unvisited, i)
 		}
 	}
+	if len(unvisited) > 0 && prior == nil {
+		return unvisited[rng.Intn(len(unvisited))]
+	}
+	if len(unvisited) > 0 {
+		weights := make([]float64, len(unvisited))
+		total := 0.0
+		for k, i := range unvisited {
+			weights[k] = prior[i]
+			total += prior[i]
+		}
+		for k := range weights {
+			weights[k] /= total
+		}
+		return unvisited[SampleIndex(weights, rng)]
+	}
+
+	bestIndex := 0
+	bestValue := math.Inf(-1)
+	logVisits := math.Log(float64(n.nbVisit))
+	for i := range visits[:width] {
+		value := scores[i]/float64(visits[i]) + EXPLORATION*math.Sqrt(logVisits/float64(visits[i]))
+		if prior != nil {
+			value += MODEL_BIAS * prior[i] / float64(visits[i]+1)
+		}
+		if value > bestValue {
+			bestValue = value
+			bestIndex = i
+		}
+	}
+	return bestIndex
+}
+
+// Rollout plays random moves for both players until the end of the game. When there is a model,
+// the opponent follows it until the end of the day: over a whole game, a modelled opponent facing
+// a random player wins most rollouts whatever the position
+func (gt *GameTree) Rollout(s State) float64 {
+	day := s.day
+	for s.day < rules.NbDays {
+		playerMoves := s.GetSearchMoves(PLAYER)
+		opponentMoves := s.GetSearchMoves(OPPONENT)
+		playerMove := playerMoves[gt.rng.Intn(len(playerMoves))]
+		opponentMove := Move{}
+		if gt.model != nil && s.day == day {
+			opponentMove = opponentMoves[SampleIndex(gt.model.GetPrior(s, opponentMoves), gt.rng)]
+		} else {
+			opponentMove = opponentMoves[gt.rng.Intn(len(opponentMoves))]
+		}
+		s = s.Play(playerMove, opponentMove)
+	}
+	return GetReward(s)
+}
+
+// GetOpponentPrior returns the prior of the model over the opponent moves of n, nil without a model
+func (gt *GameTree) GetOpponentPrior(n *Node) []float64 {
+	if gt.model == nil {
+		return nil
+	}
+	if n.opponentPrior == nil {
+		n.opponentPrior = gt.model.GetPrior(n.state, n.opponentMoveList)
+	}
+	return n.opponentPrior
+}
+
+// Iterate runs one selection, expansion, rollout and backpropagation from the root
+func (gt *GameTree) Iterate() {
+	type step struct {
+		node          *Node
+		playerIndex   int
+		opponentIndex int
+	}
+	path := []step{}
+
+	n := gt.root
+	for !n.IsTerminal() && n.nbVisit > 0 {
+		playerIndex := n.SelectMove(PLAYER, gt.rng, nil)
+		opponentIndex := n.SelectMove(OPPONENT, gt.rng, gt.GetOpponentPrior(n))
+		path = append(path, step{n, playerIndex, opponentIndex})
+		n = n.GetChild(playerIndex, opponentIndex)
+	}
 
-	for _, child := range n.children {
-		child.GetAllChildrenNodes(depth - 1)
+	reward := gt.Rollout(n.state)
+	n.nbVisit++
+	for _, st := range path {
+		st.node.nbVisit++
+		st.node.playerMoveVisits[st.playerIndex]++
+		st.node.playerMoveScore[st.playerIndex] += reward
+		st.node.opponentMoveVisits[st.opponentIndex]++
+		st.node.opponentMoveScore[st.opponentIndex] += 1 - reward
 	}
 }
 
 func (gt *GameTree) Update(s State) {
 
+	if gt.root != nil {
+		var nextRoot *Node
+		for _, child := range gt.root.children {
+			if child != nil && child.state.IsEqual(s) {
+				nextRoot = child
+				break
+			}
+		}
+		gt.root = nextRoot
+	}
+	if gt.root == nil && gt.macro {
+		gt.root = newMacroNode(s, nil)
+	}
 	if gt.root == nil {
 		gt.root = newNode(s, nil)
-	} else {
+	}
+	gt.root.parent = nil
+	gt.root.GetAllChildrenNodes(KNOWN_DEPTH, &gt.stopped)
+}
 
-		for i, child := range gt.root.children {
-			if child.state.IsEqual(s) {
-				gt.root = gt.root.children[i]
-				gt.root.parent = nil
-				break
-			}
+// GetStats returns the statistics of the moves of playerCode at the root, most visited first
+func (gt *GameTree) GetStats(playerCode int) []MoveStats {
+	moves, scores, visits := gt.root.playerMoveList, gt.root.playerMoveScore, gt.root.playerMoveVisits
+	if playerCode == OPPONENT {
+		moves, scores, visits = gt.root.opponentMoveList, gt.root.opponentMoveScore, gt.root.opponentMoveVisits
+	}
+	stats := make([]MoveStats, len(moves))
+	for i := range moves {
+		stats[i] = MoveStats{move: moves[i], visits: visits[i]}
+		if visits[i] > 0 {
+			stats[i].value = scores[i] / float64(visits[i])
 		}
 	}
-	gt.root.GetAllChildrenNodes(KNOWN_DEPTH)
+	sort.SliceStable(stats, func(i, j int) bool { return stats[i].visits > stats[j].visits })
+	return stats
 }
 
-func (gt *GameTree) Compute(t time.Duration) Move {
-	t0 := time.Now()
-	for time.Since(t0) < t {
+// Budget bounds a search: a fixed number of iterations if iterations is positive, a duration otherwise.
+// With the same seed and a number of iterations, a search returns the same move as long as the deadline,
+// if there is one, does not end it first: a search ends at the deadline whatever the rest of the budget,
+// PlayTurn says so on stderr when that cuts a number of iterations.
+type Budget struct {
+	duration   time.Duration
+	iterations int
+	deadline   time.Time
+}
 
+// IsSpent returns true once the search started at t0 has run for the whole budget
+func (b Budget) IsSpent(t0 time.Time, iterations int) bool {
+	if !b.deadline.IsZero() && time.Now().After(b.deadline) {
+		return true
 	}
-	bestMove := Move{code: WAIT}
+	if b.iterations > 0 {
+		return iterations >= b.iterations
+	}
+	return time.Since(t0) >= b.duration
+}
+
+func (b Budget) String() string {
+	if b.iterations > 0 {
+		return fmt.Sprintf("%d iterations", b.iterations)
+	}
+	return b.duration.String()
+}
+
+// Compute searches for the whole budget, or until Stop is called,
+// and returns the most visited move of the player
+func (gt *GameTree) Compute(budget Budget) Move {
+	t0 := time.Now()
+	for i := 0; !budget.IsSpent(t0, i) && !gt.stopped.Load(); i++ {
+		if gt.root.IsTerminal() {
+			break
+		}
+		gt.Iterate()
+		if i%PUBLISH_PERIOD == 0 {
+			gt.PublishBestMove(gt.GetStats(PLAYER)[0].move)
+		}
+	}
+	bestMove := gt.GetStats(PLAYER)[0].move
+	gt.PublishBestMove(bestMove)
 	return bestMove
 }
 
-func (gt *GameTree) Print() {
-	fmt.Fprintln(os.Stderr, gt.root.children[0])
+// Stop makes the running search return as soon as possible, it can be called from another goroutine
+func (gt *GameTree) Stop() {
+	gt.stopped.Store(true)
+}
+
+// Restart clears what Stop and the last search left, before a new search
+func (gt *GameTree) Restart(bestMove Move) {
+	gt.stopped.Store(false)
+	gt.PublishBestMove(bestMove)
+}
+
+func (gt *GameTree) PublishBestMove(m Move) {
+	gt.bestMove.Store(&m)
+}
+
+// GetBestMove returns the best move found so far, it can be called from another goroutine
+func (gt *GameTree) GetBestMove() Move {
+	if m := gt.bestMove.Load(); m != nil {
+		return *m
+	}
+	return Move{code: WAIT}
 }
 
 /************************************************/
@@ -537,9 +939,10 @@ func (gt *GameTree) Print() {
 /*												*/
 /************************************************/
 
-func main() {
+// readMap reads the init block and loads the map
+func readMap(scanner *bufio.Scanner) error {
 
-	// numberOfCells: 37
+	// numberOfCells: 37 on the standard board, 1 + 3 * radius * (radius + 1) in general
 	var numberOfCells int
 
 	// index: 0 is the center cell, the next cells spiral outwards
@@ -547,56 +950,175 @@ func main() {
 	// neigh0: the index of the neighbouring cell for each direction
 	var index, richness, neigh0, neigh1, neigh2, neigh3, neigh4, neigh5 int
 
-	scanner := bufio.NewScanner(os.Stdin)
-	scanner.Buffer(make([]byte, 1000000), 1000000)
-
 	scanner.Scan()
 	fmt.Sscan(scanner.Text(), &numberOfCells)
+	if numberOfCells <= 0 || numberOfCells > MAX_CELLS {
+		return fmt.Errorf("bad number of cells %d, the biggest board of this build has %d (build with -tags bigboard for more)", numberOfCells, MAX_CELLS)
+	}
+	richnessList := make([]int, numberOfCells)
+	neighboursList := make([][6]int, numberOfCells)
 	for i := 0; i < numberOfCells; i++ {
 		scanner.Scan()
 		fmt.Sscan(scanner.Text(), &index, &richness, &neigh0, &neigh1, &neigh2, &neigh3, &neigh4, &neigh5)
-		switch richness {
-		case 0:
-			richnessMap[index] = -1
-		case 1:
-			richnessMap[index] = 0
-		case 2:
-			richnessMap[index] = 2
-		case 3:
-			richnessMap[index] = 4
+		if index < 0 || index >= numberOfCells {
+			return fmt.Errorf("bad cell index %d in %q", index, scanner.Text())
 		}
-		neighboursMap[index][0] = neigh0
-		neighboursMap[index][1] = neigh1
-		neighboursMap[index][2] = neigh2
-		neighboursMap[index][3] = neigh3
-		neighboursMap[index][4] = neigh4
-		neighboursMap[index][5] = neigh5
+		richnessList[index] = richness
+		neighboursList[index] = [6]int{neigh0, neigh1, neigh2, neigh3, neigh4, neigh5}
+	}
+	return LoadMap(richnessList, neighboursList)
+}
+
+// Bot keeps the search tree from one turn to the next
+type Bot struct {
+	name        string
+	gameTree    *GameTree
+	firstToWait bool
+
+	// opponent keeps the actions of the opponent worked out from the inputs
+	opponent *OpponentTracker
+}
+
+func newBot(seed int64) *Bot {
+	return &Bot{name: "mcts", gameTree: newGameTree(seed), opponent: newOpponentTracker()}
+}
+
+// newModelBot returns a bot that learns an opponent model during the match and searches with it
+func newModelBot(seed int64) *Bot {
+	b := newBot(seed)
+	b.name = "mcts-model"
+	b.gameTree.model = newOpponentModel()
+	return b
+}
+
+// Think searches the state of the turn within the budget
+func (b *Bot) Think(state State, budget Budget) Move {
+	if b.firstToWait {
+		b.firstToWait = false
+		b.gameTree.root = nil
+	}
+	actions := b.opponent.Observe(state)
+	if b.gameTree.model != nil {
+		b.gameTree.model.Learn(actions)
 	}
 
-	gameTree := &GameTree{}
+	move, isInBook := GetBookMove(state)
+	if isInBook {
+		b.gameTree.PublishBestMove(move)
+	} else {
+		b.gameTree.Update(state)
+		move = b.gameTree.Compute(budget)
+	}
+	if move.code == WAIT && state.isWaiting[OPPONENT] == 0 {
+		b.firstToWait = true
+	}
+	b.opponent.Played(move)
+	return move
+}
+
+// main plays a game on stdin and stdout, or runs the command of its first argument. CodinGame takes a
+// single source file, it is written by go run ./cmd/bundle > codingame.go
+func main() {
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "referee":
+			runReferee(os.Args[2:])
+			return
+		case "analyze":
+			runAnalyze(os.Args[2:])
+			return
+		case "replay-input":
+			runReplayInput(os.Args[2:])
+			return
+		case "play":
+			runPlay(os.Args[2:])
+			return
+		case "book":
+			runBook(os.Args[2:])
+			return
+		}
+	}
+
+	replayPath := flag.String("replay", "", "file the bot records its own game to")
+	showBoard := flag.Bool("board", false, "draw the board on stderr every turn")
+	color := flag.Bool("color", false, "use ANSI colors when drawing the board")
+	transcriptPath := flag.String("transcript", "", "file every input line is copied to")
+	strategyName := flag.String("strategy", "mcts", "strategy playing the game: "+strings.Join(GetStrategyNames(), ", "))
+	seed := flag.Int64("seed", time.Now().UnixNano(), "seed of the search")
+	iterations := flag.Int("iterations", 0, "search this many iterations per turn instead of searching for a fixed time")
+	transcriptToStderr := flag.Bool("transcript-stderr", DEFAULT_TRANSCRIPT_STDERR, "copy every input line to stderr, prefixed with \""+TRANSCRIPT_PREFIX+"\"")
+	flag.BoolVar(&useOpeningBook, "book", true, "play the moves of the opening book in the first days")
+	gameFlags := addGameFlags(flag.CommandLine)
+	flag.Parse()
+
+	loadGameFlags(gameFlags)
+
+	strategy, err := NewStrategy(*strategyName, *seed)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	input, notes, closeInput := openInput(*transcriptPath, *transcriptToStderr)
+	defer closeInput()
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 1000000), 1000000)
+
+	if err := readMap(scanner); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var recorder *BotRecorder
+	if *replayPath != "" {
+		f, err := os.Create(*replayPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		} else {
+			defer f.Close()
+			rw := NewReplayWriter(f)
+			rw.WriteHeader()
+			recorder = NewBotRecorder(rw)
+		}
+	}
+
+	// replay-input needs the seed to replay a transcript, it only plays the same moves again when the
+	// game was searched for a number of iterations: a live game searched for a time is not replayable
+	fmt.Fprintf(notes, "bot %s seed %d iterations %d\n", *strategyName, *seed, *iterations)
+	watchdog := &Watchdog{}
 	firstRound := true
-	firstToWait := false
 	for {
-		if firstToWait {
-			firstToWait = false
-			gameTree.root = nil
+		state, ok := getData(scanner)
+		if !ok {
+			break
 		}
-		state := getData(scanner)
-
 		t0 := time.Now()
-		gameTree.Update(state)
 
-		// compute stuff while there is time
-		t := 1 * time.Millisecond
+		// search until the margin the watchdog keeps before the deadline
+		deadline := t0.Add(TURN_DEADLINE)
 		if firstRound {
 			firstRound = false
-			t = 1 * time.Millisecond
+			deadline = t0.Add(FIRST_TURN_DEADLINE)
 		}
-		move := gameTree.Compute(t)
-		if move.code == WAIT && state.isWaiting[OPPONENT] == 0 {
-			firstToWait = true
+		searchTime := time.Until(deadline.Add(-SEARCH_MARGIN))
+		move := watchdog.PlayTurn(strategy, state, Budget{duration: searchTime, iterations: *iterations}, deadline, Move.Print)
+		if watchdog.isCut {
+			fmt.Fprintf(notes, "move %s cut\n", move)
+		} else {
+			fmt.Fprintf(notes, "move %s\n", move)
+		}
+		if *showBoard {
+			RenderBoard(os.Stderr, state, *color)
 		}
-		move.Print()
 		fmt.Fprintln(os.Stderr, time.Since(t0))
+
+		if recorder != nil {
+			recorder.WriteTurn(state, move)
+		}
+	}
+
+	if recorder != nil {
+		recorder.WriteEnd()
 	}
 }
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*				STATE NOTATION					*/
+/*												*/
+/************************************************/
+
+// A state is written on one line as space separated fields, the player always comes first:
+//
+//	<day> <nutrients> <sun>/<oppSun> <score>/<oppScore> <isWaiting><oppIsWaiting> <trees> [<map>]
+//
+// trees is a comma separated list of <cell><owner><size>, or - if there are none: the owner is
+// P for the player and O for the opponent, lowercase if the tree is dormant.
+// map is optional, it is the richness (0-3) of every cell in index order, its length gives the board
+// radius (37 cells for the standard board).
+//
+//	12 18 10/7 24/30 01 0P3,19p1,22O2,28o0
+//	0 20 2/2 0/0 00 19P1,20P1,28O1,29O1 3333333222222222222111111111111111111
+
+// FormatState writes the state, with the richness of the loaded map if withMap is true
+func FormatState(s State, withMap bool) string {
+	trees := []string{}
+	for _, t := range s.GetTrees() {
+		owner := "O"
+		if t.Owner == PLAYER {
+			owner = "P"
+		}
+		if t.Dormant {
+			owner = strings.ToLower(owner)
+		}
+		trees = append(trees, fmt.Sprintf("%d%s%d", t.Cell, owner, t.Size))
+	}
+	treeField := strings.Join(trees, ",")
+	if treeField == "" {
+		treeField = "-"
+	}
+
+	str := fmt.Sprintf("%d %d %d/%d %d/%d %d%d %s",
+		s.day, s.nutrients,
+		s.sun[PLAYER], s.sun[OPPONENT],
+		s.score[PLAYER], s.score[OPPONENT],
+		s.isWaiting[PLAYER], s.isWaiting[OPPONENT],
+		treeField)
+	if withMap {
+		str += " " + FormatMap()
+	}
+	return str
+}
+
+// FormatMap writes the richness of every cell of the loaded map
+func FormatMap() string {
+	b := strings.Builder{}
+	for _, value := range richnessLevelMap[:nbCells] {
+		b.WriteString(strconv.Itoa(value))
+	}
+	return b.String()
+}
+
+// ParseMap loads the board with the richness of the map descriptor, its length gives the board radius
+func ParseMap(str string) error {
+	radius := GetRadius(len(str))
+	if radius < 0 {
+		return fmt.Errorf("a map of %d cells is not a board of radius %d to %d", len(str), MIN_BOARD_RADIUS, MAX_BOARD_RADIUS)
+	}
+	coords := GetBoardCoords(radius)
+	richness := make([]int, len(str))
+	for i, c := range str {
+		if c < '0' || c > '3' {
+			return fmt.Errorf("bad richness %q for cell %d", c, i)
+		}
+		richness[i] = int(c - '0')
+	}
+	return LoadMap(richness, GetNeighbours(coords))
+}
+
+func parsePair(str string, name string) ([2]int, error) {
+	pair := [2]int{}
+	parts := strings.Split(str, "/")
+	if len(parts) != 2 {
+		return pair, fmt.Errorf("%s must be written a/b, not %q", name, str)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return pair, fmt.Errorf("bad %s %q", name, part)
+		}
+		pair[i] = n
+	}
+	return [2]int{OPPONENT: pair[1], PLAYER: pair[0]}, nil
+}
+
+// ParseState reads a state written by FormatState, the map is loaded if the notation has one,
+// otherwise the currently loaded map is used. The loaded map is left as it was if the notation is bad.
+func ParseState(str string) (State, error) {
+	fields := strings.Fields(str)
+	if len(fields) != 6 && len(fields) != 7 {
+		return newState(), fmt.Errorf("expected 6 or 7 fields, got %d", len(fields))
+	}
+	previousMap := GetMapRecord()
+	s, err := parseState(fields)
+	if err != nil && len(fields) == 7 && len(previousMap) > 0 {
+		LoadMapRecord(previousMap)
+	}
+	return s, err
+}
+
+func parseState(fields []string) (State, error) {
+	s := newState()
+	if len(fields) == 7 {
+		if err := ParseMap(fields[6]); err != nil {
+			return s, err
+		}
+	}
+
+	var err error
+	if s.day, err = strconv.Atoi(fields[0]); err != nil || s.day < 0 {
+		return s, fmt.Errorf("bad day %q", fields[0])
+	}
+	if s.nutrients, err = strconv.Atoi(fields[1]); err != nil || s.nutrients < 0 {
+		return s, fmt.Errorf("bad nutrients %q", fields[1])
+	}
+	if s.sun, err = parsePair(fields[2], "sun"); err != nil {
+		return s, err
+	}
+	if s.score, err = parsePair(fields[3], "score"); err != nil {
+		return s, err
+	}
+	waiting := fields[4]
+	if len(waiting) != 2 || strings.Trim(waiting, "01") != "" {
+		return s, fmt.Errorf("waiting flags must be two 0 or 1, not %q", waiting)
+	}
+	s.isWaiting[PLAYER], s.isWaiting[OPPONENT] = int(waiting[0]-'0'), int(waiting[1]-'0')
+
+	if fields[5] != "-" {
+		for _, tree := range strings.Split(fields[5], ",") {
+			if s, err = parseTree(s, tree); err != nil {
+				return s, err
+			}
+		}
+	}
+
+	// going through a record sorts the trees and computes the costs and shadows
+	return s.Record().State(), nil
+}
+
+func parseTree(s State, str string) (State, error) {
+	i := strings.IndexAny(str, "PpOo")
+	if i <= 0 || i != len(str)-2 {
+		return s, fmt.Errorf("bad tree %q", str)
+	}
+	cell, err := strconv.Atoi(str[:i])
+	if err != nil || cell < 0 || cell >= nbCells {
+		return s, fmt.Errorf("bad cell in tree %q", str)
+	}
+	if richnessMap[cell] < 0 {
+		return s, fmt.Errorf("tree %q is on an unusable cell", str)
+	}
+	if s.treeMap[cell] != -1 {
+		return s, fmt.Errorf("two trees on cell %d", cell)
+	}
+	size := int(str[i+1] - '0')
+	if size < 0 || size > 3 {
+		return s, fmt.Errorf("bad size in tree %q", str)
+	}
+	owner := OPPONENT
+	if str[i] == 'P' || str[i] == 'p' {
+		owner = PLAYER
+	}
+	isDormant := str[i] == 'p' || str[i] == 'o'
+	return s.AddTree(cell, size, owner, isDormant), nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// playRandomGame returns every state of a game between two random players on a new map of the
+// default radius, the map stays loaded
+func playRandomGame(seed int64) []State {
+	rng := rand.New(rand.NewSource(seed))
+	s := NewGame(rng, DEFAULT_BOARD_RADIUS)
+	states := []State{s}
+	for s.day < rules.NbDays {
+		playerMoves, opponentMoves := s.GetLegalMoves(PLAYER), s.GetLegalMoves(OPPONENT)
+		s = s.Play(playerMoves[rng.Intn(len(playerMoves))], opponentMoves[rng.Intn(len(opponentMoves))])
+		states = append(states, s)
+	}
+	return states
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		for _, s := range playRandomGame(seed) {
+			str := FormatState(s, true)
+			parsed, err := ParseState(str)
+			if err != nil {
+				t.Fatalf("seed %d: ParseState(%q): %v", seed, str, err)
+			}
+			if !parsed.IsEqual(s) {
+				t.Fatalf("seed %d: %q is not parsed back to the same state", seed, str)
+			}
+			if again := FormatState(parsed, true); again != str {
+				t.Fatalf("seed %d: %q is written back as %q", seed, str, again)
+			}
+		}
+	}
+}
+
+func TestParseStateErrors(t *testing.T) {
+	playRandomGame(1)
+	for _, str := range []string{
+		"",
+		"0 20 2/2 0/0 00",
+		"x 20 2/2 0/0 00 -",
+		"0 20 2 0/0 00 -",
+		"0 20 2/2 0/0 02 -",
+		"0 20 2/2 0/0 00 19X1",
+		"0 20 2/2 0/0 00 - 3333",
+	} {
+		if _, err := ParseState(str); err == nil {
+			t.Errorf("ParseState(%q) accepted a bad notation", str)
+		}
+	}
+}
+
+func TestParseStateKeepsMapOnError(t *testing.T) {
+	playRandomGame(1)
+	loaded := FormatMap()
+	if _, err := ParseState("0 20 2/2 0/0 00 99P1 " + strings.Repeat("3", GetNbCells(2))); err == nil {
+		t.Fatal("a tree out of the board accepted")
+	}
+	if FormatMap() != loaded {
+		t.Errorf("the map of a bad notation is left loaded")
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*					OBJECTIVE					*/
+/*												*/
+/************************************************/
+
+// The objective is what the searches try to get out of a finished game, set with -objective:
+//
+//	win     win the game: the best score wins, then the most trees, otherwise it is a draw
+//	margin  the biggest final score difference
+//	score   the biggest final score of our own
+//
+// The searches only see its values: GetReward between 0 and 1 at the end of the rollouts of the
+// tree searches, GetTerminalValue in points for flat Monte Carlo and the searches that evaluate
+// states. With win, a won game is worth OBJECTIVE_WIN_POINTS more to the latter than any margin.
+// DEFAULT_OBJECTIVE is margin, the final score difference flat Monte Carlo and Evaluate always
+// played for: the tree searches, which used to play for the win alone, now play for it too.
+
+const (
+	OBJECTIVE_WIN = iota
+	OBJECTIVE_MARGIN
+	OBJECTIVE_SCORE
+)
+
+const (
+	DEFAULT_OBJECTIVE = "margin"
+
+	// a win is worth this many points to the searches that evaluate states, more than any margin
+	OBJECTIVE_WIN_POINTS = 1000
+
+	// the reward of the margin objective is close to 1 past a few OBJECTIVE_MARGIN_SCALE points,
+	// that of the score objective is 1/2 for OBJECTIVE_SCORE_SCALE points
+	OBJECTIVE_MARGIN_SCALE = 20.0
+	OBJECTIVE_SCORE_SCALE  = 50.0
+)
+
+var objectiveNames = map[string]int{
+	"win":    OBJECTIVE_WIN,
+	"margin": OBJECTIVE_MARGIN,
+	"score":  OBJECTIVE_SCORE,
+}
+
+var objective = OBJECTIVE_MARGIN
+
+func GetObjectiveNames() []string {
+	names := []string{}
+	for name := range objectiveNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadObjective sets the objective of the searches
+func LoadObjective(name string) error {
+	o, ok := objectiveNames[name]
+	if !ok {
+		return fmt.Errorf("unknown objective %q, known ones are %s", name, strings.Join(GetObjectiveNames(), ", "))
+	}
+	objective = o
+	return nil
+}
+
+// GetNbTrees returns the number of trees of playerCode, of every size
+func (s State) GetNbTrees(playerCode int) int {
+	return s.nbTrees[playerCode][0] + s.nbTrees[playerCode][1] + s.nbTrees[playerCode][2] + s.nbTrees[playerCode][3]
+}
+
+// GetWinner returns the winner of a finished game, PLAYER or OPPONENT, or -1 for a draw:
+// the best final score, then the most trees
+func (s State) GetWinner() int {
+	score := s.GetFinalScores()
+	nbTrees := [2]int{s.GetNbTrees(OPPONENT), s.GetNbTrees(PLAYER)}
+	switch {
+	case score[PLAYER] != score[OPPONENT]:
+		if score[PLAYER] > score[OPPONENT] {
+			return PLAYER
+		}
+		return OPPONENT
+	case nbTrees[PLAYER] > nbTrees[OPPONENT]:
+		return PLAYER
+	case nbTrees[PLAYER] < nbTrees[OPPONENT]:
+		return OPPONENT
+	}
+	return -1
+}
+
+// GetReward returns the value of a finished game for the player under the objective, between 0 and 1:
+// 1 for a win, 0.5 for a draw and 0 for a loss, or the margin or the score squashed
+func GetReward(s State) float64 {
+	score := s.GetFinalScores()
+	switch objective {
+	case OBJECTIVE_MARGIN:
+		return 0.5 + 0.5*math.Tanh(float64(score[PLAYER]-score[OPPONENT])/OBJECTIVE_MARGIN_SCALE)
+	case OBJECTIVE_SCORE:
+		return float64(score[PLAYER]) / (float64(score[PLAYER]) + OBJECTIVE_SCORE_SCALE)
+	}
+	switch s.GetWinner() {
+	case PLAYER:
+		return 1
+	case OPPONENT:
+		return 0
+	}
+	return 0.5
+}
+
+// GetTerminalValue returns the value of a finished game for the player under the objective, in points:
+// OBJECTIVE_WIN_POINTS for a win plus the margin, the margin or the score
+func GetTerminalValue(s State) float64 {
+	score := s.GetFinalScores()
+	margin := float64(score[PLAYER] - score[OPPONENT])
+	switch objective {
+	case OBJECTIVE_MARGIN:
+		return margin
+	case OBJECTIVE_SCORE:
+		return float64(score[PLAYER])
+	}
+	switch s.GetWinner() {
+	case PLAYER:
+		return OBJECTIVE_WIN_POINTS + margin
+	case OPPONENT:
+		return -OBJECTIVE_WIN_POINTS + margin
+	}
+	return 0
+}
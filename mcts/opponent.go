@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+/************************************************/
+/*												*/
+/*				OPPONENT TRACKING				*/
+/*												*/
+/************************************************/
+
+// The game never tells the bot what its opponent played, only the next state. The opponent
+// actions are worked out by comparing the trees of two consecutive inputs: a tree that disappeared
+// was completed, a tree one size bigger was grown, a new seed was thrown by a tree in range.
+// A bot that waits is not asked again before the next day, so the opponent may have played several
+// actions between two inputs: their order is not known and they are listed completes first,
+// then grows from the biggest tree, then seeds.
+
+// OpponentAction is an action of the opponent worked out from two consecutive inputs,
+// sunSpent is its cost when it was played in that order
+type OpponentAction struct {
+	day      int
+	move     Move
+	sunSpent int
+}
+
+// GetOpponentActions returns the actions the opponent played between the states previous and
+// next, playerMove being the action PLAYER played from previous.
+// The thrower of a seed is one of the trees that could have thrown it when several could, and
+// is -1 when none could. A seed that failed because PLAYER seeded the same cell has that target,
+// and -1 as a target otherwise, it spent no sun.
+func GetOpponentActions(previous State, next State, playerMove Move) []OpponentAction {
+	if previous.isWaiting[OPPONENT] == 1 {
+		return nil
+	}
+	isNewDay := next.day != previous.day
+
+	completes, grows, seeds := []Move{}, []Move{}, []Move{}
+	used := map[int]bool{}
+	for cell := 0; cell < nbCells; cell++ {
+		wasOwned := previous.treeMap[cell] >= 0 && previous.GetOwner(cell) == OPPONENT
+		isOwned := next.treeMap[cell] >= 0 && next.GetOwner(cell) == OPPONENT
+		switch {
+		case wasOwned && !isOwned:
+			completes = append(completes, Move{code: COMPLETE, treeIndex: cell})
+			used[cell] = true
+		case wasOwned && previous.treeMap[cell] == 3 && next.treeMap[cell] == 0:
+			// completed, then seeded again
+			completes = append(completes, Move{code: COMPLETE, treeIndex: cell})
+			seeds = append(seeds, Move{code: SEED, treeIndex: -1, targetIndex: cell})
+			used[cell] = true
+		case wasOwned && next.treeMap[cell] == previous.treeMap[cell]+1:
+			grows = append(grows, Move{code: GROW, treeIndex: cell})
+			used[cell] = true
+		case !wasOwned && isOwned && next.treeMap[cell] == 0:
+			seeds = append(seeds, Move{code: SEED, treeIndex: -1, targetIndex: cell})
+		}
+	}
+	sort.SliceStable(grows, func(i, j int) bool {
+		return previous.treeMap[grows[i].treeIndex] > previous.treeMap[grows[j].treeIndex]
+	})
+
+	// the trees that were active and did not grow could have thrown the seeds, on the same day
+	// only those that went dormant did
+	throwers := []int{}
+	for _, cell := range previous.activeTreesIndex[OPPONENT] {
+		if !used[cell] && previous.treeMap[cell] > 0 && (isNewDay || next.IsDormant(cell)) {
+			throwers = append(throwers, cell)
+		}
+	}
+	for i, m := range seeds {
+		for _, cell := range throwers {
+			if !used[cell] && GetDistance(cell, m.targetIndex) <= previous.treeMap[cell] {
+				seeds[i].treeIndex = cell
+				used[cell] = true
+				break
+			}
+		}
+	}
+
+	// a tree that went dormant without throwing a seed lost it to a seed of PLAYER on the same cell
+	failedSeeds := []Move{}
+	if !isNewDay {
+		for _, cell := range throwers {
+			if used[cell] {
+				continue
+			}
+			m := Move{code: SEED, treeIndex: cell, targetIndex: -1}
+			if playerMove.code == SEED {
+				m.targetIndex = playerMove.targetIndex
+			}
+			failedSeeds = append(failedSeeds, m)
+		}
+	}
+
+	actions := []OpponentAction{}
+	sim := previous.CloneTrees()
+	play := func(m Move, isSuccessful bool) {
+		sun := sim.sun[OPPONENT]
+		switch m.code {
+		case COMPLETE:
+			sim = sim.Complete(m, OPPONENT)
+		case GROW:
+			sim = sim.Grow(m, OPPONENT)
+		case SEED:
+			if m.treeIndex >= 0 {
+				sim = sim.Seed(m, OPPONENT, isSuccessful)
+			} else {
+				sim.sun[OPPONENT] -= sim.GetSeedCost(OPPONENT)
+				sim.nbTrees[OPPONENT][0]++
+			}
+		}
+		actions = append(actions, OpponentAction{day: previous.day, move: m, sunSpent: sun - sim.sun[OPPONENT]})
+	}
+	for _, m := range completes {
+		play(m, true)
+	}
+	for _, m := range grows {
+		play(m, true)
+	}
+	for _, m := range seeds {
+		play(m, true)
+	}
+	for _, m := range failedSeeds {
+		play(m, false)
+	}
+	if isNewDay || next.isWaiting[OPPONENT] == 1 {
+		actions = append(actions, OpponentAction{day: previous.day, move: Move{code: WAIT}})
+	}
+	return actions
+}
+
+// OpponentTracker keeps the history of the opponent actions over a whole match:
+// Observe is called with every input and Played with the action PLAYER played from it
+type OpponentTracker struct {
+	previous    State
+	playerMove  Move
+	hasPrevious bool
+	history     []OpponentAction
+}
+
+func newOpponentTracker() *OpponentTracker {
+	return &OpponentTracker{history: []OpponentAction{}}
+}
+
+// Observe works out the opponent actions since the previous input, adds them to the history
+// and returns them
+func (ot *OpponentTracker) Observe(s State) []OpponentAction {
+	actions := []OpponentAction{}
+	if ot.hasPrevious && s.day >= ot.previous.day {
+		actions = GetOpponentActions(ot.previous, s, ot.playerMove)
+		ot.history = append(ot.history, actions...)
+	}
+	ot.previous, ot.playerMove, ot.hasPrevious = s, Move{code: WAIT}, true
+	return actions
+}
+
+// Played sets the action PLAYER played from the last observed state
+func (ot *OpponentTracker) Played(m Move) {
+	ot.playerMove = m
+}
+
+// GetHistory returns every action of the opponent so far, oldest first
+func (ot *OpponentTracker) GetHistory() []OpponentAction {
+	return ot.history
+}
+
+// GetDayActions returns the actions the opponent played on day
+func (ot *OpponentTracker) GetDayActions(day int) []OpponentAction {
+	actions := []OpponentAction{}
+	for _, a := range ot.history {
+		if a.day == day {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+// GetCodeCounts returns how many times the opponent played each action, indexed by action code
+func (ot *OpponentTracker) GetCodeCounts() [4]int {
+	counts := [4]int{}
+	for _, a := range ot.history {
+		counts[a.move.code]++
+	}
+	return counts
+}
+
+// GetSunSpent returns the sun the opponent spent on its actions so far
+func (ot *OpponentTracker) GetSunSpent() int {
+	sunSpent := 0
+	for _, a := range ot.history {
+		sunSpent += a.sunSpent
+	}
+	return sunSpent
+}
+
+func (a OpponentAction) String() string {
+	return fmt.Sprintf("day %d: %s (%d sun)", a.day, a.move, a.sunSpent)
+}
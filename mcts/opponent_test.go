@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+// recordGame plays a game between two registered strategies with the referee and reads its replay back,
+// the map of the game stays loaded
+func recordGame(t *testing.T, names [2]string, seed int64) *Replay {
+	t.Helper()
+	players := [2]player{}
+	for i, name := range names {
+		strategy, err := NewStrategy(name, seed+int64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		players[i] = &strategyPlayer{strategy: strategy, budget: Budget{iterations: 50}}
+	}
+	s := NewGame(rand.New(rand.NewSource(seed)), DEFAULT_BOARD_RADIUS)
+	var b bytes.Buffer
+	RunMatch(players, s, NewReplayWriter(&b))
+	replay, err := ReadReplay(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return replay
+}
+
+func TestGetOpponentActionsAgainstReplays(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		names := [2]string{PLAYER: "random", OPPONENT: "random"}
+		if seed%2 == 0 {
+			names = [2]string{PLAYER: "seeder", OPPONENT: "seeder"}
+		}
+		replay := recordGame(t, names, seed)
+		for i := 0; i+1 < len(replay.Turns); i++ {
+			turn := replay.Turns[i]
+			previous, next := turn.State.State(), replay.Turns[i+1].State.State()
+			playerMove, err := ParseMove(turn.PlayerMove)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opponentMove, err := ParseMove(turn.OpponentMove)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actions := GetOpponentActions(previous, next, playerMove)
+			switch {
+			case previous.isWaiting[OPPONENT] == 1:
+				if actions != nil {
+					t.Errorf("seed %d, turn %d: %v for a waiting opponent", seed, i, actions)
+				}
+			case opponentMove.code == WAIT:
+				if len(actions) != 1 || actions[0].move.code != WAIT {
+					t.Errorf("seed %d, turn %d: %v for WAIT", seed, i, actions)
+				}
+			default:
+				if len(actions) != 1 || actions[0].move != opponentMove {
+					t.Errorf("seed %d, turn %d: %v for %s", seed, i, actions, opponentMove)
+				} else if spent := previous.sun[OPPONENT] - next.sun[OPPONENT]; actions[0].sunSpent != spent {
+					t.Errorf("seed %d, turn %d: %s spent %d sun, not %d", seed, i, opponentMove, spent, actions[0].sunSpent)
+				}
+			}
+		}
+	}
+}
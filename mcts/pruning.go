@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*				SEED PRUNING					*/
+/*												*/
+/************************************************/
+
+// The searches play from GetSearchMoves, the legal moves without the seeds the pruning rules mark as
+// dominated. The referee and the legality checks keep using GetLegalMoves.
+// The rules are set with -prune, a comma separated list of:
+//
+//	merge          keep one seed per target, thrown by the smallest tree
+//	min-richness=N prune the seeds on cells of richness under N (1-3)
+//	own-shade=N    prune the seeds on cells shaded by more than N of our own trees of size 2 or 3
+//	last-days=N    prune every seed of the last N days
+//
+// or "none" to search every legal move.
+
+type PruningRules struct {
+	MergeTargets  bool
+	MinRichness   int
+	MaxOwnShaders int
+	LastSeedDays  int
+}
+
+// DEFAULT_PRUNING is what the bot plays with. The rules are global, two settings are compared
+// with bot processes: referee -p1 "./bot -prune none" -p2 "./bot"
+const DEFAULT_PRUNING = "merge,own-shade=1,last-days=2"
+
+var pruning = PruningRules{MergeTargets: true, MaxOwnShaders: 1, LastSeedDays: 2}
+
+// ParsePruningRules reads a list of pruning rules as given to -prune
+func ParsePruningRules(str string) (PruningRules, error) {
+	p := PruningRules{MaxOwnShaders: -1}
+	if str == "none" || str == "" {
+		return p, nil
+	}
+	for _, rule := range strings.Split(str, ",") {
+		name, value, hasValue := strings.Cut(strings.TrimSpace(rule), "=")
+		n, err := strconv.Atoi(value)
+		if hasValue && (err != nil || n < 0) {
+			return p, fmt.Errorf("bad value %q for the pruning rule %q", value, name)
+		}
+		switch {
+		case name == "merge" && !hasValue:
+			p.MergeTargets = true
+		case name == "min-richness" && hasValue:
+			p.MinRichness = n
+		case name == "own-shade" && hasValue:
+			p.MaxOwnShaders = n
+		case name == "last-days" && hasValue:
+			p.LastSeedDays = n
+		default:
+			return p, fmt.Errorf("unknown pruning rule %q, known ones are merge, min-richness=N, own-shade=N and last-days=N", rule)
+		}
+	}
+	return p, nil
+}
+
+// LoadPruningRules sets the pruning rules used by the searches
+func LoadPruningRules(str string) error {
+	p, err := ParsePruningRules(str)
+	if err != nil {
+		return err
+	}
+	pruning = p
+	return nil
+}
+
+func (p PruningRules) String() string {
+	names := []string{}
+	if p.MergeTargets {
+		names = append(names, "merge")
+	}
+	if p.MinRichness > 0 {
+		names = append(names, fmt.Sprintf("min-richness=%d", p.MinRichness))
+	}
+	if p.MaxOwnShaders >= 0 {
+		names = append(names, fmt.Sprintf("own-shade=%d", p.MaxOwnShaders))
+	}
+	if p.LastSeedDays > 0 {
+		names = append(names, fmt.Sprintf("last-days=%d", p.LastSeedDays))
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
+
+// GetOwnShaders returns how many trees of size 2 or 3 of playerCode shade cell on some day
+func (s State) GetOwnShaders(cell int, playerCode int) int {
+	nbShaders := 0
+	for _, trees := range [][]int{s.activeTreesIndex[playerCode], s.dormantTreesIndex[playerCode]} {
+		for _, treeIndex := range trees {
+			size := s.treeMap[treeIndex]
+			if size >= 2 && IsInLine(treeIndex, cell) && GetDistance(treeIndex, cell) <= size {
+				nbShaders++
+			}
+		}
+	}
+	return nbShaders
+}
+
+// IsDominatedSeed returns true if the pruning rules drop the seed m of playerCode
+func (s State) IsDominatedSeed(m Move, playerCode int) bool {
+	switch {
+	case s.day >= rules.NbDays-pruning.LastSeedDays:
+		return true
+	case richnessLevelMap[m.targetIndex] < pruning.MinRichness:
+		return true
+	case pruning.MaxOwnShaders >= 0 && s.GetOwnShaders(m.targetIndex, playerCode) > pruning.MaxOwnShaders:
+		return true
+	}
+	return false
+}
+
+// GetSearchMoves returns the legal moves of playerCode without the seeds the pruning rules drop
+func (s State) GetSearchMoves(playerCode int) []Move {
+	moves := s.GetLegalMoves(playerCode)
+	throwers := [MAX_CELLS]int{}
+	for i := range throwers[:nbCells] {
+		throwers[i] = -1
+	}
+	kept := moves[:0]
+	for _, m := range moves {
+		if m.code != SEED {
+			kept = append(kept, m)
+			continue
+		}
+		if s.IsDominatedSeed(m, playerCode) {
+			continue
+		}
+		if !pruning.MergeTargets {
+			kept = append(kept, m)
+			continue
+		}
+		// the seed takes the place of the first seed on its target, with the smallest thrower
+		i := throwers[m.targetIndex]
+		switch {
+		case i < 0:
+			throwers[m.targetIndex] = len(kept)
+			kept = append(kept, m)
+		case s.treeMap[m.treeIndex] < s.treeMap[kept[i].treeIndex]:
+			kept[i] = m
+		}
+	}
+	return kept
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*					REFEREE						*/
+/*												*/
+/************************************************/
+
+const (
+	FIRST_TURN_TIMEOUT = 1000 * time.Millisecond
+	TURN_TIMEOUT       = 100 * time.Millisecond
+)
+
+// botProcess is a bot run as a child process, talking through its stdin and stdout
+type botProcess struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	output chan string
+
+	// missed counts the answers of the turns the bot timed out on that it did not print yet,
+	// they are dropped when they come so that the next answers go with their turns
+	missed int
+}
+
+func startBot(command string, stderr io.Writer) (*botProcess, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty bot command")
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	b := &botProcess{cmd: cmd, stdin: stdin, output: make(chan string)}
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			b.output <- scanner.Text()
+		}
+		close(b.output)
+	}()
+	return b, nil
+}
+
+func (b *botProcess) send(lines []string) error {
+	_, err := io.WriteString(b.stdin, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// readLine waits for the answer to the last input, the late answers to the previous ones are dropped
+func (b *botProcess) readLine(timeout time.Duration) (string, error) {
+	timer := time.After(timeout)
+	for {
+		select {
+		case line, ok := <-b.output:
+			if !ok {
+				return "", fmt.Errorf("bot exited")
+			}
+			if b.missed > 0 {
+				b.missed--
+				fmt.Fprintf(os.Stderr, "referee: late answer %q dropped\n", line)
+				continue
+			}
+			return line, nil
+		case <-timer:
+			b.missed++
+			return "", fmt.Errorf("timeout after %v", timeout)
+		}
+	}
+}
+
+// stop closes the bot's input so it can finish cleanly, and kills it if it does not exit in time
+func (b *botProcess) stop() {
+	b.stdin.Close()
+	done := make(chan struct{})
+	go func() {
+		for range b.output {
+		}
+		b.cmd.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(FIRST_TURN_TIMEOUT):
+		b.cmd.Process.Kill()
+		<-done
+	}
+}
+
+// GetInitInput returns the lines of the init block for the loaded map
+func GetInitInput() []string {
+	lines := []string{fmt.Sprint(nbCells)}
+	for _, c := range GetMapRecord() {
+		n := c.Neighbours
+		lines = append(lines, fmt.Sprintf("%d %d %d %d %d %d %d %d", c.Index, c.Richness, n[0], n[1], n[2], n[3], n[4], n[5]))
+	}
+	return lines
+}
+
+// GetTurnInput returns the lines of the turn input of s as seen by the player playerCode
+func GetTurnInput(s State, playerCode int) []string {
+	other := 1 - playerCode
+	lines := []string{
+		fmt.Sprint(s.day),
+		fmt.Sprint(s.nutrients),
+		fmt.Sprintf("%d %d", s.sun[playerCode], s.score[playerCode]),
+		fmt.Sprintf("%d %d %d", s.sun[other], s.score[other], s.isWaiting[other]),
+	}
+
+	trees := s.GetTrees()
+	lines = append(lines, fmt.Sprint(len(trees)))
+	for _, t := range trees {
+		isMine, isDormant := 0, 0
+		if t.Owner == playerCode {
+			isMine = 1
+		}
+		if t.Dormant {
+			isDormant = 1
+		}
+		lines = append(lines, fmt.Sprintf("%d %d %d %d", t.Cell, t.Size, isMine, isDormant))
+	}
+
+	moves := s.GetLegalMoves(playerCode)
+	lines = append(lines, fmt.Sprint(len(moves)))
+	for _, m := range moves {
+		lines = append(lines, m.String())
+	}
+	return lines
+}
+
+// IsLegal returns true if m is one of the legal moves of playerCode
+func (s State) IsLegal(m Move, playerCode int) bool {
+	for _, legalMove := range s.GetLegalMoves(playerCode) {
+		if legalMove == m {
+			return true
+		}
+	}
+	return false
+}
+
+// player is one side of a game run by the referee
+type player interface {
+	// GetMove returns the move of playerCode in s, WAIT if it fails to answer in time or plays an illegal move
+	GetMove(s State, playerCode int, timeout time.Duration) Move
+	Close()
+}
+
+func (b *botProcess) GetMove(s State, playerCode int, timeout time.Duration) Move {
+	return askMove(b, s, playerCode, timeout)
+}
+
+func (b *botProcess) Close() {
+	b.stop()
+}
+
+// strategyPlayer runs a strategy in the referee's process
+type strategyPlayer struct {
+	strategy Strategy
+	budget   Budget
+	watchdog Watchdog
+}
+
+func (sp *strategyPlayer) GetMove(s State, playerCode int, timeout time.Duration) Move {
+	view := s.GetView(playerCode)
+	m := sp.watchdog.PlayTurn(sp.strategy, view, sp.budget, time.Now().Add(timeout), func(Move) {})
+	if !view.IsLegal(m, PLAYER) {
+		fmt.Fprintf(os.Stderr, "referee: player %d: illegal move %q\n", playerCode, m)
+		return Move{code: WAIT}
+	}
+	return m
+}
+
+func (sp *strategyPlayer) Close() {}
+
+// newPlayer runs the strategy registered under name in process, or the command as a bot process
+func newPlayer(nameOrCommand string, seed int64, budget Budget, stderr io.Writer) (player, error) {
+	if _, ok := strategyRegistry[nameOrCommand]; ok {
+		strategy, err := NewStrategy(nameOrCommand, seed)
+		if err != nil {
+			return nil, err
+		}
+		return &strategyPlayer{strategy: strategy, budget: budget}, nil
+	}
+	b, err := startBot(nameOrCommand, stderr)
+	if err != nil {
+		return nil, err
+	}
+	b.send(GetInitInput())
+	return b, nil
+}
+
+// RunMatch plays a game between two players from the state s (the map must be loaded),
+// records it if recorder is not nil and returns the final state
+func RunMatch(players [2]player, s State, recorder *ReplayWriter) State {
+	if recorder != nil {
+		recorder.WriteHeader()
+	}
+
+	timeout := FIRST_TURN_TIMEOUT
+	for s.day < rules.NbDays {
+		moves := [2]Move{{code: WAIT}, {code: WAIT}}
+		for _, playerCode := range []int{PLAYER, OPPONENT} {
+			if s.isWaiting[playerCode] == 1 {
+				continue
+			}
+			moves[playerCode] = players[playerCode].GetMove(s, playerCode, timeout)
+		}
+		timeout = TURN_TIMEOUT
+
+		nextState := s.Play(moves[PLAYER], moves[OPPONENT])
+		sunGained := [2]int{}
+		if nextState.day != s.day && nextState.day < rules.NbDays {
+			sunGained = nextState.GetSunIncome()
+		}
+		if recorder != nil {
+			recorder.WriteTurn(s, moves[PLAYER], &moves[OPPONENT], sunGained)
+		}
+		s = nextState
+	}
+
+	if recorder != nil {
+		recorder.WriteEnd(s.GetFinalScores())
+	}
+	return s
+}
+
+// runReferee plays games between two bots and records them as replays,
+// a bot is either the name of a registered strategy, run in process, or a command
+func runReferee(args []string) {
+	flags := flag.NewFlagSet("referee", flag.ExitOnError)
+	p1 := flags.String("p1", "", "strategy or command of the first bot (PLAYER in the replay)")
+	p2 := flags.String("p2", "", "strategy or command of the second bot (OPPONENT in the replay)")
+	seed := flags.Int64("seed", time.Now().UnixNano(), "seed of the map generator and of the strategies")
+	nbGames := flags.Int("games", 1, "number of games, each on a new map")
+	searchTime := flags.Duration("time", 50*time.Millisecond, "search time per turn of the strategies run in process")
+	iterations := flags.Int("iterations", 0, "search iterations per turn of the strategies run in process, instead of a time")
+	replayPath := flags.String("replay", "", "file the game is recorded to, numbered after the first game")
+	verbose := flags.Bool("v", false, "forward the bots' stderr")
+	radius := flags.Int("radius", DEFAULT_BOARD_RADIUS, fmt.Sprintf("radius of the generated boards (%d to %d)", MIN_BOARD_RADIUS, MAX_BOARD_RADIUS))
+	flags.BoolVar(&useOpeningBook, "book", true, "play the moves of the opening book in the first days")
+	gameFlags := addGameFlags(flags)
+	flags.Parse(args)
+
+	loadGameFlags(gameFlags)
+	if err := CheckBoardRadius(*radius); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *p1 == "" || *p2 == "" {
+		fmt.Fprintln(os.Stderr, "referee: -p1 and -p2 are required, strategies are", strings.Join(GetStrategyNames(), ", "))
+		os.Exit(2)
+	}
+
+	var stderr io.Writer
+	if *verbose {
+		stderr = os.Stderr
+	}
+	budget := Budget{duration: *searchTime, iterations: *iterations}
+	commands := [2]string{OPPONENT: *p2, PLAYER: *p1}
+
+	// results[PLAYER] counts the wins of the first bot, results[OPPONENT] its losses
+	results := [2]int{}
+	totalScore := [2]int{}
+	for game := 0; game < *nbGames; game++ {
+		gameSeed := *seed + int64(game)
+		final, err := runRefereeGame(commands, gameSeed, *radius, budget, getReplayPath(*replayPath, game), stderr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "referee:", err)
+			os.Exit(1)
+		}
+		score := final.GetFinalScores()
+		fmt.Printf("%d %d\n", score[PLAYER], score[OPPONENT])
+
+		totalScore[PLAYER] += score[PLAYER]
+		totalScore[OPPONENT] += score[OPPONENT]
+		// equal scores are decided by the number of trees
+		if winner := final.GetWinner(); winner >= 0 {
+			results[winner]++
+		}
+	}
+	if *nbGames > 1 {
+		fmt.Printf("p1 wins %d, draws %d, losses %d, mean score %.1f to %.1f\n",
+			results[PLAYER], *nbGames-results[PLAYER]-results[OPPONENT], results[OPPONENT],
+			float64(totalScore[PLAYER])/float64(*nbGames), float64(totalScore[OPPONENT])/float64(*nbGames))
+	}
+}
+
+// getReplayPath numbers the replay files after the first game: game.jsonl, game-1.jsonl...
+func getReplayPath(path string, game int) string {
+	if path == "" || game == 0 {
+		return path
+	}
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(path, ext), game, ext)
+}
+
+// runRefereeGame plays one game on the map of the given radius generated from seed, with new players,
+// and returns its final state
+func runRefereeGame(commands [2]string, seed int64, radius int, budget Budget, replayPath string, stderr io.Writer) (State, error) {
+	var recorder *ReplayWriter
+	if replayPath != "" {
+		f, err := os.Create(replayPath)
+		if err != nil {
+			return State{}, err
+		}
+		defer f.Close()
+		recorder = NewReplayWriter(f)
+	}
+
+	s := NewGame(rand.New(rand.NewSource(seed)), radius)
+
+	players := [2]player{}
+	for i, command := range commands {
+		p, err := newPlayer(command, seed+int64(i), budget, stderr)
+		if err != nil {
+			return State{}, err
+		}
+		defer p.Close()
+		players[i] = p
+	}
+
+	return RunMatch(players, s, recorder), nil
+}
+
+// askMove sends the turn input to the bot and reads its action,
+// a bot that fails to answer or plays an illegal move waits instead
+func askMove(b *botProcess, s State, playerCode int, timeout time.Duration) Move {
+	wait := Move{code: WAIT}
+	if err := b.send(GetTurnInput(s, playerCode)); err != nil {
+		fmt.Fprintf(os.Stderr, "referee: player %d: %v\n", playerCode, err)
+		return wait
+	}
+	line, err := b.readLine(timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "referee: player %d: %v\n", playerCode, err)
+		return wait
+	}
+	m, err := ParseMove(line)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "referee: player %d: %v\n", playerCode, err)
+		return wait
+	}
+	if !s.IsLegal(m, playerCode) {
+		fmt.Fprintf(os.Stderr, "referee: player %d: illegal move %q\n", playerCode, line)
+		return wait
+	}
+	return m
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*				BOARD RENDERING					*/
+/*												*/
+/************************************************/
+
+// Every cell is drawn with 4 characters:
+//
+//	P3z~	a tree: owner (P = player, O = opponent), size (0-3), z if dormant, shadow mark
+//	 .2~	an empty cell: its richness (1-3), shadow mark
+//	 ##	an unusable cell
+//
+// The shadow mark is ~ if the cell is in the shadow of a tree for the current sun
+// direction and * if that shadow is big enough to stop the tree on it from collecting sun.
+// The index of every cell is written under it.
+// With colors, the player is green, the opponent red and shadowed cells have a grey background.
+
+const (
+	ANSI_RESET    = "\033[0m"
+	ANSI_DIM      = "\033[2m"
+	ANSI_BOLD     = "\033[1m"
+	ANSI_GREEN    = "\033[32m"
+	ANSI_RED      = "\033[31m"
+	ANSI_YELLOW   = "\033[33m"
+	ANSI_SHADOWED = "\033[48;5;238m"
+
+	CELL_WIDTH = 6
+)
+
+var directionNames = [6]string{"EAST", "NE", "NW", "WEST", "SW", "SE"}
+
+// GetShadowSize returns the size of the biggest tree shading cell (1-3), 0 if it is not shaded
+func (s State) GetShadowSize(cell int) int {
+	for size := 3; size > 0; size-- {
+		if s.shadowMap[size-1][cell] > 0 {
+			return size
+		}
+	}
+	return 0
+}
+
+func renderCell(s State, cell int, color bool) string {
+	paint := func(str string, codes ...string) string {
+		if !color || len(codes) == 0 {
+			return str
+		}
+		return strings.Join(codes, "") + str + ANSI_RESET
+	}
+
+	shadowSize := s.GetShadowSize(cell)
+	shadowMark := " "
+	background := []string{}
+	if shadowSize > 0 {
+		shadowMark = "~"
+		if s.treeMap[cell] > 0 && shadowSize >= s.treeMap[cell] {
+			shadowMark = "*"
+		}
+		background = append(background, ANSI_SHADOWED)
+	}
+
+	if richnessMap[cell] < 0 {
+		return paint(" ## ", ANSI_DIM)
+	}
+
+	if s.treeMap[cell] < 0 {
+		richness := fmt.Sprintf(" .%d", richnessLevelMap[cell])
+		return paint(richness, append(background, ANSI_YELLOW)...) + paint(shadowMark, background...)
+	}
+
+	owner, codes := "O", append(background, ANSI_BOLD, ANSI_RED)
+	if s.GetOwner(cell) == PLAYER {
+		owner, codes = "P", append(background, ANSI_BOLD, ANSI_GREEN)
+	}
+	dormant := " "
+	if s.IsDormant(cell) {
+		dormant = "z"
+	}
+	return paint(fmt.Sprintf("%s%d", owner, s.treeMap[cell]), codes...) + paint(dormant+shadowMark, background...)
+}
+
+// RenderBoard draws the state as a hex board, with ANSI colors if color is true
+func RenderBoard(w io.Writer, s State, color bool) {
+	sunDirection := s.day % 6
+	fmt.Fprintf(w, "day %d  sun %s  nutrients %d\n", s.day, directionNames[sunDirection], s.nutrients)
+	for _, playerCode := range []int{PLAYER, OPPONENT} {
+		name := "player  "
+		if playerCode == OPPONENT {
+			name = "opponent"
+		}
+		waiting := ""
+		if s.isWaiting[playerCode] == 1 {
+			waiting = "  (waiting)"
+		}
+		fmt.Fprintf(w, "%s  sun %3d  score %3d  trees %v%s\n", name, s.sun[playerCode], s.score[playerCode], s.nbTrees[playerCode], waiting)
+	}
+
+	// rows go from north to south, a cell (q, r) is drawn at column 2q + r
+	rows := map[int][]int{}
+	minRow, maxRow, minCol := 0, 0, 0
+	for cell := 0; cell < nbCells; cell++ {
+		q, r := cellCoords[cell][0], cellCoords[cell][1]
+		rows[r] = append(rows[r], cell)
+		minRow, maxRow = min(minRow, r), max(maxRow, r)
+		minCol = min(minCol, 2*q+r)
+	}
+
+	for r := minRow; r <= maxRow; r++ {
+		cells := rows[r]
+		sort.Slice(cells, func(i, j int) bool { return cellCoords[cells[i]][0] < cellCoords[cells[j]][0] })
+
+		// each row of cells is followed by their indexes
+		line, indexLine := strings.Builder{}, strings.Builder{}
+		column := 0
+		for _, cell := range cells {
+			position := (2*cellCoords[cell][0] + r - minCol) * CELL_WIDTH / 2
+			line.WriteString(strings.Repeat(" ", position-column))
+			line.WriteString(renderCell(s, cell, color))
+			indexLine.WriteString(strings.Repeat(" ", position-column))
+			index := fmt.Sprintf("%3d ", cell)
+			if color {
+				index = ANSI_DIM + index + ANSI_RESET
+			}
+			indexLine.WriteString(index)
+			column = position + 4
+		}
+		fmt.Fprintln(w, line.String())
+		fmt.Fprintln(w, indexLine.String())
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+/************************************************/
+/*												*/
+/*					REPLAY FILES				*/
+/*												*/
+/************************************************/
+
+// A replay file is a JSON-lines file: every line is one record, told apart by its "type".
+//
+// The first line is the header, it stores the format version and the map as given in the
+// init block (richness 0-3 and the 6 neighbours of every cell):
+//
+//	{"type":"header","version":1,"cells":[{"index":0,"richness":3,"neighbours":[1,2,3,4,5,6]},...],
+//	 "rules":{"growBaseCost":[1,3,7],"seedBaseCost":0,...}}
+//
+// The rules are those of the game, files without them were played with the default league.
+//
+// Then comes one turn record per action, holding the state the action was chosen from,
+// the actions of both players and the sun they collected if the action ended the day:
+//
+//	{"type":"turn","state":{"day":0,"nutrients":20,"sun":[2,2],"score":[0,0],"isWaiting":[0,0],
+//	 "trees":[{"cell":19,"size":1,"owner":1,"dormant":false},...]},
+//	 "playerMove":"GROW 19","opponentMove":"WAIT","sunGained":[0,0]}
+//
+// The last line is the end record with the final scores (score + sun/3):
+//
+//	{"type":"end","score":[71,64]}
+//
+// Every [2] array uses the State convention: index 1 (PLAYER) is the player the replay is
+// recorded for (the first bot for the referee), index 0 (OPPONENT) is its opponent.
+// A bot only knows its own actions, so opponentMove is left empty in the replays it records.
+// Readers must reject files whose version is greater than the one they know.
+
+const (
+	REPLAY_VERSION = 1
+
+	RECORD_HEADER = "header"
+	RECORD_TURN   = "turn"
+	RECORD_END    = "end"
+)
+
+type CellRecord struct {
+	Index      int    `json:"index"`
+	Richness   int    `json:"richness"`
+	Neighbours [6]int `json:"neighbours"`
+}
+
+type TreeRecord struct {
+	Cell    int  `json:"cell"`
+	Size    int  `json:"size"`
+	Owner   int  `json:"owner"`
+	Dormant bool `json:"dormant"`
+}
+
+type StateRecord struct {
+	Day       int          `json:"day"`
+	Nutrients int          `json:"nutrients"`
+	Sun       [2]int       `json:"sun"`
+	Score     [2]int       `json:"score"`
+	IsWaiting [2]int       `json:"isWaiting"`
+	Trees     []TreeRecord `json:"trees"`
+}
+
+type TurnRecord struct {
+	State        StateRecord `json:"state"`
+	PlayerMove   string      `json:"playerMove"`
+	OpponentMove string      `json:"opponentMove"`
+	SunGained    [2]int      `json:"sunGained"`
+}
+
+// ReplayRecord is a single line of a replay file, only the fields of its type are set
+type ReplayRecord struct {
+	Type    string       `json:"type"`
+	Version int          `json:"version,omitempty"`
+	Cells   []CellRecord `json:"cells,omitempty"`
+	Rules   *Rules       `json:"rules,omitempty"`
+	*TurnRecord
+	Score *[2]int `json:"score,omitempty"`
+}
+
+// Replay is a whole replay file once read
+type Replay struct {
+	Version  int
+	Cells    []CellRecord
+	Rules    Rules
+	Turns    []TurnRecord
+	Score    [2]int
+	Finished bool
+}
+
+// GetTrees returns every tree of the state sorted by cell index
+func (s State) GetTrees() []TreeRecord {
+	trees := []TreeRecord{}
+	for i := 0; i < 2; i++ {
+		for _, treeIndex := range s.activeTreesIndex[i] {
+			trees = append(trees, TreeRecord{Cell: treeIndex, Size: s.treeMap[treeIndex], Owner: i})
+		}
+		for _, treeIndex := range s.dormantTreesIndex[i] {
+			trees = append(trees, TreeRecord{Cell: treeIndex, Size: s.treeMap[treeIndex], Owner: i, Dormant: true})
+		}
+	}
+	sort.Slice(trees, func(i, j int) bool { return trees[i].Cell < trees[j].Cell })
+	return trees
+}
+
+func (s State) Record() StateRecord {
+	return StateRecord{
+		Day:       s.day,
+		Nutrients: s.nutrients,
+		Sun:       s.sun,
+		Score:     s.score,
+		IsWaiting: s.isWaiting,
+		Trees:     s.GetTrees(),
+	}
+}
+
+// State rebuilds the state, richnessMap and neighboursMap must already be loaded
+func (r StateRecord) State() State {
+	s := newState()
+	s.day = r.Day
+	s.nutrients = r.Nutrients
+	s.sun = r.Sun
+	s.score = r.Score
+	s.isWaiting = r.IsWaiting
+	for _, t := range r.Trees {
+		s = s.AddTree(t.Cell, t.Size, t.Owner, t.Dormant)
+	}
+	sort.Ints(s.activeTreesIndex[PLAYER])
+	sort.Ints(s.activeTreesIndex[OPPONENT])
+	sort.Ints(s.dormantTreesIndex[PLAYER])
+	sort.Ints(s.dormantTreesIndex[OPPONENT])
+	s = s.UpdateGrowCosts()
+	s = s.UpdateShadows()
+	return s
+}
+
+// GetMapRecord returns the currently loaded map the way the header stores it
+func GetMapRecord() []CellRecord {
+	cells := make([]CellRecord, nbCells)
+	for i := range cells {
+		cells[i] = CellRecord{Index: i, Richness: richnessLevelMap[i], Neighbours: neighboursMap[i]}
+	}
+	return cells
+}
+
+// LoadMapRecord sets richnessMap and neighboursMap from the cells of a header
+func LoadMapRecord(cells []CellRecord) error {
+	richness := make([]int, len(cells))
+	neighbours := make([][6]int, len(cells))
+	for _, c := range cells {
+		if c.Index < 0 || c.Index >= len(cells) {
+			return fmt.Errorf("bad cell index %d in a map of %d cells", c.Index, len(cells))
+		}
+		richness[c.Index] = c.Richness
+		neighbours[c.Index] = c.Neighbours
+	}
+	return LoadMap(richness, neighbours)
+}
+
+type ReplayWriter struct {
+	encoder *json.Encoder
+}
+
+func NewReplayWriter(w io.Writer) *ReplayWriter {
+	return &ReplayWriter{encoder: json.NewEncoder(w)}
+}
+
+// WriteHeader writes the header with the currently loaded map and rules
+func (rw *ReplayWriter) WriteHeader() error {
+	r := rules
+	return rw.encoder.Encode(ReplayRecord{Type: RECORD_HEADER, Version: REPLAY_VERSION, Cells: GetMapRecord(), Rules: &r})
+}
+
+// WriteTurn writes one turn, an opponent move of nil is left empty
+func (rw *ReplayWriter) WriteTurn(s State, playerMove Move, opponentMove *Move, sunGained [2]int) error {
+	turn := &TurnRecord{State: s.Record(), PlayerMove: playerMove.String(), SunGained: sunGained}
+	if opponentMove != nil {
+		turn.OpponentMove = opponentMove.String()
+	}
+	return rw.encoder.Encode(ReplayRecord{Type: RECORD_TURN, TurnRecord: turn})
+}
+
+func (rw *ReplayWriter) WriteEnd(score [2]int) error {
+	return rw.encoder.Encode(ReplayRecord{Type: RECORD_END, Score: &score})
+}
+
+// BotRecorder records the game of a bot, which only sees the states it is asked to play from: the
+// sun collected at the start of a day is only known from its first state, so every turn is written
+// once the next state is known and the sun goes with the turn whose action ended the previous day
+type BotRecorder struct {
+	rw       *ReplayWriter
+	last     State
+	lastMove Move
+	hasLast  bool
+}
+
+func NewBotRecorder(rw *ReplayWriter) *BotRecorder {
+	return &BotRecorder{rw: rw}
+}
+
+// WriteTurn writes the previous turn and keeps this one until the next state is known
+func (br *BotRecorder) WriteTurn(s State, playerMove Move) error {
+	err := br.flush(s.day, s.GetSunIncome())
+	br.last, br.lastMove, br.hasLast = s, playerMove, true
+	return err
+}
+
+// WriteEnd writes the last turn and the end record with the final scores of the last state
+func (br *BotRecorder) WriteEnd() error {
+	if err := br.flush(rules.NbDays, [2]int{}); err != nil {
+		return err
+	}
+	return br.rw.WriteEnd(br.last.GetFinalScores())
+}
+
+// flush writes the kept turn, followed by a state of nextDay whose players collected income if it
+// is a new day
+func (br *BotRecorder) flush(nextDay int, income [2]int) error {
+	if !br.hasLast {
+		return nil
+	}
+	sunGained := [2]int{}
+	if nextDay != br.last.day && nextDay < rules.NbDays {
+		sunGained = income
+	}
+	br.hasLast = false
+	return br.rw.WriteTurn(br.last, br.lastMove, nil, sunGained)
+}
+
+// ReadReplay reads a whole replay file, the map is not loaded
+func ReadReplay(r io.Reader) (*Replay, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1000000), 1000000)
+
+	replay := &Replay{}
+	line := 0
+	for scanner.Scan() {
+		line++
+		record := ReplayRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		switch record.Type {
+		case RECORD_HEADER:
+			if record.Version > REPLAY_VERSION {
+				return nil, fmt.Errorf("line %d: unsupported replay version %d", line, record.Version)
+			}
+			replay.Version = record.Version
+			replay.Cells = record.Cells
+			replay.Rules = leagueRules[DEFAULT_LEAGUE]
+			if record.Rules != nil {
+				replay.Rules = *record.Rules
+			}
+		case RECORD_TURN:
+			if record.TurnRecord == nil {
+				return nil, fmt.Errorf("line %d: empty turn", line)
+			}
+			replay.Turns = append(replay.Turns, *record.TurnRecord)
+		case RECORD_END:
+			if record.Score != nil {
+				replay.Score = *record.Score
+			}
+			replay.Finished = true
+		default:
+			return nil, fmt.Errorf("line %d: unknown record type %q", line, record.Type)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if replay.Version == 0 {
+		return nil, fmt.Errorf("missing replay header")
+	}
+	return replay, nil
+}
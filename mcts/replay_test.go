@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestReadReplay(t *testing.T) {
+	replay := recordGame(t, [2]string{"greedy", "random"}, 1)
+	if replay.Version != REPLAY_VERSION || !replay.Finished || len(replay.Turns) == 0 {
+		t.Fatalf("version %d, finished %v, %d turns", replay.Version, replay.Finished, len(replay.Turns))
+	}
+	last := replay.Turns[len(replay.Turns)-1]
+	playerMove, _ := ParseMove(last.PlayerMove)
+	opponentMove, _ := ParseMove(last.OpponentMove)
+	if score := last.State.State().Play(playerMove, opponentMove).GetFinalScores(); score != replay.Score {
+		t.Errorf("the last turn ends with %v, the replay with %v", score, replay.Score)
+	}
+}
+
+func TestReadReplayRejectsNewerVersion(t *testing.T) {
+	header := fmt.Sprintf(`{"type":%q,"version":%d}`, RECORD_HEADER, REPLAY_VERSION+1)
+	if _, err := ReadReplay(strings.NewReader(header + "\n")); err == nil {
+		t.Errorf("version %d accepted", REPLAY_VERSION+1)
+	}
+}
+
+// getDaySunGained returns the sun gained on the last turn of every day of a replay
+func getDaySunGained(replay *Replay) map[int][2]int {
+	sunGained := map[int][2]int{}
+	for _, turn := range replay.Turns {
+		sunGained[turn.State.Day] = turn.SunGained
+	}
+	return sunGained
+}
+
+func TestBotReplaySunGained(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		refereeReplay := recordGame(t, [2]string{"greedy", "random"}, seed)
+
+		// the bot only sees the states it plays from
+		var b bytes.Buffer
+		rw := NewReplayWriter(&b)
+		rw.WriteHeader()
+		recorder := NewBotRecorder(rw)
+		for _, turn := range refereeReplay.Turns {
+			s := turn.State.State()
+			if s.isWaiting[PLAYER] == 1 {
+				continue
+			}
+			m, err := ParseMove(turn.PlayerMove)
+			if err != nil {
+				t.Fatal(err)
+			}
+			recorder.WriteTurn(s, m)
+		}
+		recorder.WriteEnd()
+		botReplay, err := ReadReplay(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected, sunGained := getDaySunGained(refereeReplay), getDaySunGained(botReplay)
+		for day := 0; day < rules.NbDays; day++ {
+			if sunGained[day] != expected[day] {
+				t.Errorf("seed %d: the bot gained %v at the end of day %d, the referee says %v", seed, sunGained[day], day, expected[day])
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*			ROLLING HORIZON EVOLUTION			*/
+/*												*/
+/************************************************/
+
+// The "rhea" strategy evolves sequences of our next actions instead of growing a tree. A genome
+// holds RHEA_LENGTH genes, each one picks an action among the search moves of the state it is
+// played in, so that a genome stays playable whatever happened before. It is played against the
+// modelled opponent until RHEA_DAYS days have passed, or the game ended, and gets the value of
+// Evaluate in the state it reaches. The first action of the best genome is played, the population
+// is kept for the next turn with the played gene dropped.
+
+const (
+	RHEA_LENGTH     = 16
+	RHEA_DAYS       = 2
+	RHEA_POPULATION = 12
+	RHEA_ELITES     = 2
+
+	// probability of drawing a gene again in a child
+	RHEA_MUTATION = 0.15
+)
+
+type Genome struct {
+	genes []float64
+	value float64
+}
+
+type RHEAStrategy struct {
+	rng        *rand.Rand
+	population []Genome
+	opponent   *OpponentTracker
+	model      *OpponentModel
+}
+
+func newRHEAStrategy(seed int64) *RHEAStrategy {
+	return &RHEAStrategy{
+		rng:      rand.New(rand.NewSource(seed)),
+		opponent: newOpponentTracker(),
+		model:    newOpponentModel(),
+	}
+}
+
+func (r *RHEAStrategy) Name() string {
+	return "rhea"
+}
+
+func (r *RHEAStrategy) Reset() {
+	r.population = nil
+}
+
+// PickMove returns the move the gene picks among moves
+func PickMove(moves []Move, gene float64) Move {
+	return moves[min(int(gene*float64(len(moves))), len(moves)-1)]
+}
+
+// newRandomGenome returns a genome of random genes
+func (r *RHEAStrategy) newRandomGenome() Genome {
+	genes := make([]float64, RHEA_LENGTH)
+	for i := range genes {
+		genes[i] = r.rng.Float64()
+	}
+	return Genome{genes: genes}
+}
+
+// Evaluate plays the genome from s, the opponent drawing its moves from the model with rng,
+// and returns the value of the state reached
+func (r *RHEAStrategy) Evaluate(s State, genes []float64, rng *rand.Rand) float64 {
+	lastDay := min(s.day+RHEA_DAYS, rules.NbDays)
+	next := 0
+	for s.day < lastDay {
+		playerMove := Move{code: WAIT}
+		if s.isWaiting[PLAYER] == 0 && next < len(genes) {
+			playerMove = PickMove(s.GetSearchMoves(PLAYER), genes[next])
+			next++
+		}
+		opponentMove := Move{code: WAIT}
+		if s.isWaiting[OPPONENT] == 0 {
+			opponentMoves := s.GetSearchMoves(OPPONENT)
+			opponentMove = opponentMoves[SampleIndex(r.model.GetPrior(s, opponentMoves), rng)]
+		}
+		s = s.Play(playerMove, opponentMove)
+	}
+	return Evaluate(s)
+}
+
+// Crossover returns a child taking each gene from one of the parents, then mutated
+func (r *RHEAStrategy) Crossover(a Genome, b Genome) Genome {
+	genes := make([]float64, RHEA_LENGTH)
+	for i := range genes {
+		switch {
+		case r.rng.Float64() < RHEA_MUTATION:
+			genes[i] = r.rng.Float64()
+		case r.rng.Intn(2) == 0:
+			genes[i] = a.genes[i]
+		default:
+			genes[i] = b.genes[i]
+		}
+	}
+	return Genome{genes: genes}
+}
+
+// SelectParent returns the best of two genomes drawn at random
+func (r *RHEAStrategy) SelectParent() Genome {
+	a, b := r.population[r.rng.Intn(len(r.population))], r.population[r.rng.Intn(len(r.population))]
+	if a.value >= b.value {
+		return a
+	}
+	return b
+}
+
+func (r *RHEAStrategy) Choose(s State, budget Budget) Move {
+	r.model.Learn(r.opponent.Observe(s))
+	for len(r.population) < RHEA_POPULATION {
+		r.population = append(r.population, r.newRandomGenome())
+	}
+
+	// every generation plays all its genomes against the same opponent moves
+	t0 := time.Now()
+	nbEvaluations := 0
+	evaluateAll := func(genomes []Genome) {
+		seed := r.rng.Int63()
+		for i := range genomes {
+			genomes[i].value = r.Evaluate(s, genomes[i].genes, rand.New(rand.NewSource(seed)))
+			nbEvaluations++
+		}
+		sort.SliceStable(genomes, func(i, j int) bool { return genomes[i].value > genomes[j].value })
+	}
+	evaluateAll(r.population)
+	for !budget.IsSpent(t0, nbEvaluations) {
+		next := append([]Genome{}, r.population[:RHEA_ELITES]...)
+		for len(next) < RHEA_POPULATION {
+			next = append(next, r.Crossover(r.SelectParent(), r.SelectParent()))
+		}
+		evaluateAll(next)
+		r.population = next
+	}
+
+	best := r.population[0]
+	move := Move{code: WAIT}
+	if s.isWaiting[PLAYER] == 0 {
+		move = PickMove(s.GetSearchMoves(PLAYER), best.genes[0])
+	}
+
+	// the next turn starts from the genomes without the gene just played
+	for i, g := range r.population {
+		r.population[i] = Genome{genes: append(append([]float64{}, g.genes[1:]...), r.rng.Float64())}
+	}
+	r.opponent.Played(move)
+	return move
+}
+
+func init() {
+	RegisterStrategy("rhea", func(seed int64) Strategy { return newRHEAStrategy(seed) })
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*					RULES						*/
+/*												*/
+/************************************************/
+
+// Rules holds every number of the game rules, so the bots can play in every league and in variants.
+// Move generation, the simulation and the referee all read the rules from the rules variable.
+type Rules struct {
+	// GrowBaseCost[size] is the cost of growing a tree of size when the player has no tree of size+1,
+	// every such tree adds 1
+	GrowBaseCost [3]int `json:"growBaseCost"`
+
+	// SeedBaseCost is the cost of seeding when the player has no seed, every seed adds 1
+	SeedBaseCost int `json:"seedBaseCost"`
+
+	CompleteCost int `json:"completeCost"`
+
+	// NbDays is the number of days of a game, the last one is NbDays-1
+	NbDays int `json:"nbDays"`
+
+	StartingNutrients int `json:"startingNutrients"`
+
+	// StartingTreeSize is the size of the two trees each player starts with
+	StartingTreeSize int `json:"startingTreeSize"`
+
+	// RichnessBonus[richness] is the bonus for completing a tree on a cell of richness 1-3
+	RichnessBonus [4]int `json:"richnessBonus"`
+
+	// AllowedActions[code] is true if the action of that code (SEED, GROW, COMPLETE, WAIT) can be played
+	AllowedActions [4]bool `json:"allowedActions"`
+}
+
+// leagueRules stores the rules of the CodinGame leagues, the wood leagues play a shorter game
+// with less actions
+var leagueRules = map[string]Rules{
+	"wood2": {
+		GrowBaseCost:      [3]int{1, 3, 7},
+		CompleteCost:      4,
+		NbDays:            1,
+		StartingNutrients: 20,
+		StartingTreeSize:  3,
+		RichnessBonus:     [4]int{0, 0, 2, 4},
+		AllowedActions:    [4]bool{COMPLETE: true, WAIT: true},
+	},
+	"wood1": {
+		GrowBaseCost:      [3]int{1, 3, 7},
+		CompleteCost:      4,
+		NbDays:            6,
+		StartingNutrients: 20,
+		StartingTreeSize:  1,
+		RichnessBonus:     [4]int{0, 0, 2, 4},
+		AllowedActions:    [4]bool{GROW: true, COMPLETE: true, WAIT: true},
+	},
+	"bronze": {
+		GrowBaseCost:      [3]int{1, 3, 7},
+		CompleteCost:      4,
+		NbDays:            24,
+		StartingNutrients: 20,
+		StartingTreeSize:  1,
+		RichnessBonus:     [4]int{0, 0, 2, 4},
+		AllowedActions:    [4]bool{SEED: true, GROW: true, COMPLETE: true, WAIT: true},
+	},
+}
+
+// DEFAULT_LEAGUE has the full rules, the ones of every league from bronze up
+const DEFAULT_LEAGUE = "bronze"
+
+var rules = leagueRules[DEFAULT_LEAGUE]
+
+func GetLeagueNames() []string {
+	names := []string{}
+	for name := range leagueRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadRules sets the rules of a league, or the rules read from a JSON file for a variant:
+// the file holds the fields of Rules, the ones it leaves out keep the default league's values.
+// It must be called before the map is loaded.
+func LoadRules(leagueOrPath string) error {
+	if r, ok := leagueRules[leagueOrPath]; ok {
+		rules = r
+		return nil
+	}
+	data, err := os.ReadFile(leagueOrPath)
+	if err != nil {
+		return fmt.Errorf("unknown league %q (known ones are %s): %v", leagueOrPath, strings.Join(GetLeagueNames(), ", "), err)
+	}
+	r := leagueRules[DEFAULT_LEAGUE]
+	if err := json.Unmarshal(data, &r); err != nil {
+		return fmt.Errorf("%s: %v", leagueOrPath, err)
+	}
+	if !r.AllowedActions[WAIT] {
+		return fmt.Errorf("%s: WAIT must be allowed", leagueOrPath)
+	}
+	rules = r
+	return nil
+}
+
+// GameFlags are the flags of the rules and of the searches, shared by the bot and every command
+type GameFlags struct {
+	league    *string
+	prune     *string
+	objective *string
+}
+
+// addGameFlags defines -league, -prune and -objective on flags. The objective is margin unless
+// -objective says otherwise, for every search: before it could be chosen, the tree searches
+// played for the win.
+func addGameFlags(flags *flag.FlagSet) GameFlags {
+	return GameFlags{
+		league:    flags.String("league", DEFAULT_LEAGUE, "rules of the game: a league ("+strings.Join(GetLeagueNames(), ", ")+") or a JSON rules file"),
+		prune:     flags.String("prune", DEFAULT_PRUNING, "seeds the searches drop: none or a list of merge, min-richness=N, own-shade=N, last-days=N"),
+		objective: flags.String("objective", DEFAULT_OBJECTIVE, "what the searches play for: "+strings.Join(GetObjectiveNames(), ", ")),
+	}
+}
+
+// loadGameFlags loads the rules, the pruning rules and the objective once the flags are parsed,
+// it exits on a bad value
+func loadGameFlags(gf GameFlags) {
+	for _, err := range []error{LoadRules(*gf.league), LoadPruningRules(*gf.prune), LoadObjective(*gf.objective)} {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*					STRATEGIES					*/
+/*												*/
+/************************************************/
+
+// Strategy chooses the move of PLAYER in a state, within a budget.
+// A strategy lives for a whole game, it can keep what it learns from one turn to the next.
+type Strategy interface {
+	Name() string
+	Choose(s State, budget Budget) Move
+}
+
+// Interruptible strategies can be driven by the watchdog from another goroutine:
+// Stop ends the running search and GetBestMove returns the best move it found so far.
+// Played tells the strategy which move was really played when it was not its own choice.
+type Interruptible interface {
+	Strategy
+	Restart(fallback Move)
+	Stop()
+	GetBestMove() Move
+	Played(s State, m Move)
+}
+
+// Resettable strategies drop what they kept from the previous turns when Reset is called,
+// after a panic for instance
+type Resettable interface {
+	Reset()
+}
+
+var strategyRegistry = map[string]func(seed int64) Strategy{}
+
+// RegisterStrategy makes a strategy available under name, it is called from init functions
+func RegisterStrategy(name string, newStrategy func(seed int64) Strategy) {
+	if _, ok := strategyRegistry[name]; ok {
+		panic("strategy registered twice: " + name)
+	}
+	strategyRegistry[name] = newStrategy
+}
+
+// NewStrategy returns a new instance of the strategy registered under name
+func NewStrategy(name string, seed int64) (Strategy, error) {
+	newStrategy, ok := strategyRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %q, known ones are %s", name, strings.Join(GetStrategyNames(), ", "))
+	}
+	return newStrategy(seed), nil
+}
+
+func GetStrategyNames() []string {
+	names := []string{}
+	for name := range strategyRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Swap returns the state as seen by the opponent, so that a strategy can play either side
+func (s State) Swap() State {
+	s.sun[0], s.sun[1] = s.sun[1], s.sun[0]
+	s.score[0], s.score[1] = s.score[1], s.score[0]
+	s.nbTrees[0], s.nbTrees[1] = s.nbTrees[1], s.nbTrees[0]
+	s.activeTreesIndex[0], s.activeTreesIndex[1] = s.activeTreesIndex[1], s.activeTreesIndex[0]
+	s.dormantTreesIndex[0], s.dormantTreesIndex[1] = s.dormantTreesIndex[1], s.dormantTreesIndex[0]
+	s.isWaiting[0], s.isWaiting[1] = s.isWaiting[1], s.isWaiting[0]
+	s.growCost[0], s.growCost[1] = s.growCost[1], s.growCost[0]
+	return s
+}
+
+// GetView returns the state as seen by playerCode, as PLAYER
+func (s State) GetView(playerCode int) State {
+	if playerCode == OPPONENT {
+		return s.Swap()
+	}
+	return s
+}
+
+func init() {
+	RegisterStrategy("mcts", func(seed int64) Strategy { return newBot(seed) })
+	RegisterStrategy("mcts-model", func(seed int64) Strategy { return newModelBot(seed) })
+	RegisterStrategy("mcts-macro", func(seed int64) Strategy { return newMacroBot(seed) })
+	RegisterStrategy("greedy", func(seed int64) Strategy { return GreedyStrategy{} })
+	RegisterStrategy("random", func(seed int64) Strategy { return &RandomStrategy{rng: rand.New(rand.NewSource(seed))} })
+}
+
+func (b *Bot) Name() string {
+	return b.name
+}
+
+func (b *Bot) Choose(s State, budget Budget) Move {
+	return b.Think(s, budget)
+}
+
+func (b *Bot) Restart(fallback Move) {
+	b.gameTree.Restart(fallback)
+}
+
+func (b *Bot) Stop() {
+	b.gameTree.Stop()
+}
+
+func (b *Bot) GetBestMove() Move {
+	return b.gameTree.GetBestMove()
+}
+
+// Played makes the tree follow the move that was really played
+func (b *Bot) Played(s State, m Move) {
+	b.firstToWait = m.code == WAIT && s.isWaiting[OPPONENT] == 0
+	b.opponent.Played(m)
+}
+
+func (b *Bot) Reset() {
+	b.gameTree.root = nil
+	b.firstToWait = false
+}
+
+// GreedyStrategy plays the move that costs the least sun for the points it brings,
+// the way the first bot (bot.go) does, and waits when nothing can be played
+type GreedyStrategy struct{}
+
+func (g GreedyStrategy) Name() string {
+	return "greedy"
+}
+
+func (g GreedyStrategy) Choose(s State, budget Budget) Move {
+	bestMove := Move{code: WAIT}
+	bestValue := 0
+	for _, m := range s.GetLegalMoves(PLAYER) {
+		if m.code == WAIT {
+			continue
+		}
+		next := s.Play(m, Move{code: WAIT})
+		value := (next.score[PLAYER] - s.score[PLAYER]) + (next.sun[PLAYER] - s.sun[PLAYER])
+		if bestMove.code == WAIT || value > bestValue {
+			bestMove = m
+			bestValue = value
+		}
+	}
+	return bestMove
+}
+
+// RandomStrategy plays one of its legal moves uniformly at random
+type RandomStrategy struct {
+	rng *rand.Rand
+}
+
+func (r *RandomStrategy) Name() string {
+	return "random"
+}
+
+func (r *RandomStrategy) Choose(s State, budget Budget) Move {
+	moves := s.GetLegalMoves(PLAYER)
+	return moves[r.rng.Intn(len(moves))]
+}
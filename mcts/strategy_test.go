@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestSameSeedSameMove(t *testing.T) {
+	states := playRandomGame(3)
+	budget := Budget{iterations: 300}
+	for _, name := range []string{"mcts", "mcts-macro", "rhea", "flatmc", "alphabeta", "nash"} {
+		for _, day := range []int{2, 10} {
+			s := states[0]
+			for _, st := range states {
+				if st.day == day {
+					s = st
+					break
+				}
+			}
+			moves := [2]Move{}
+			for i := range moves {
+				strategy, err := NewStrategy(name, 42)
+				if err != nil {
+					t.Fatal(err)
+				}
+				moves[i] = strategy.Choose(s, budget)
+			}
+			if moves[0] != moves[1] {
+				t.Errorf("%s on day %d: %s then %s with the same seed and budget", name, day, moves[0], moves[1])
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"sort"
+)
+
+/************************************************/
+/*												*/
+/*				BOARD SYMMETRIES				*/
+/*												*/
+/************************************************/
+
+// The hex board has 12 symmetries: the 6 rotations and the 6 reflections. A symmetry of the
+// board is one of the game when it keeps the richness of every cell and the sun directions of
+// every remaining day: shadows are cast towards the sun, so a symmetry that turns the sun of a
+// day would change the shadows of that day. In a full game only the last day, whose sun can be
+// kept by the reflection across its axis, has another symmetry than the identity, the shorter
+// games of the wood leagues and of small variants have more.
+// Symmetric states have the same canonical form, the transform of the smallest notation. It only
+// merges states where the game has symmetries, the analyze shell shows it, the opening book of the
+// first days does without it.
+
+// Symmetry maps every cell and direction to its image
+type Symmetry struct {
+	cells      [MAX_CELLS]int
+	directions [6]int
+}
+
+// mapSymmetries are the symmetries of the board that keep the richness of the loaded map,
+// the identity first
+var mapSymmetries []Symmetry
+
+// LoadSymmetries computes mapSymmetries, once the map is loaded. A symmetry is linear in axial
+// coordinates: it is known from the images of the EAST and SE directions.
+func LoadSymmetries() {
+	indexes := map[[2]int]int{}
+	for cell := 0; cell < nbCells; cell++ {
+		indexes[cellCoords[cell]] = cell
+	}
+
+	mapSymmetries = []Symmetry{}
+	for _, isReflection := range []bool{false, true} {
+		for rotation := 0; rotation < 6; rotation++ {
+			sym := Symmetry{}
+			for dir := 0; dir < 6; dir++ {
+				sym.directions[dir] = (rotation + dir) % 6
+				if isReflection {
+					sym.directions[dir] = (rotation - dir + 6) % 6
+				}
+			}
+			east, se := directionVectors[sym.directions[EAST]], directionVectors[sym.directions[SE]]
+
+			isKept := true
+			for cell := 0; cell < nbCells && isKept; cell++ {
+				q, r := cellCoords[cell][0], cellCoords[cell][1]
+				image, ok := indexes[[2]int{q*east[0] + r*se[0], q*east[1] + r*se[1]}]
+				isKept = ok && richnessLevelMap[image] == richnessLevelMap[cell]
+				sym.cells[cell] = image
+			}
+			if isKept {
+				mapSymmetries = append(mapSymmetries, sym)
+			}
+		}
+	}
+}
+
+// KeepsSun returns true if the symmetry keeps the sun direction of every day from day to the end
+func (sym Symmetry) KeepsSun(day int) bool {
+	for d := day; d < rules.NbDays && d < day+6; d++ {
+		if sym.directions[d%6] != d%6 {
+			return false
+		}
+	}
+	return true
+}
+
+// GetSymmetries returns the symmetries of the game in s, the identity first
+func (s State) GetSymmetries() []Symmetry {
+	symmetries := []Symmetry{}
+	for _, sym := range mapSymmetries {
+		if sym.KeepsSun(s.day) {
+			symmetries = append(symmetries, sym)
+		}
+	}
+	return symmetries
+}
+
+func (sym Symmetry) Inverse() Symmetry {
+	inverse := Symmetry{}
+	for cell := 0; cell < nbCells; cell++ {
+		inverse.cells[sym.cells[cell]] = cell
+	}
+	for dir := 0; dir < 6; dir++ {
+		inverse.directions[sym.directions[dir]] = dir
+	}
+	return inverse
+}
+
+// Transform returns the image of the state by the symmetry
+func (s State) Transform(sym Symmetry) State {
+	t := newState()
+	t.day = s.day
+	t.nutrients = s.nutrients
+	t.sun = s.sun
+	t.score = s.score
+	t.isWaiting = s.isWaiting
+	for _, tree := range s.GetTrees() {
+		t = t.AddTree(sym.cells[tree.Cell], tree.Size, tree.Owner, tree.Dormant)
+	}
+	for i := 0; i < 2; i++ {
+		sort.Ints(t.activeTreesIndex[i])
+		sort.Ints(t.dormantTreesIndex[i])
+	}
+	t = t.UpdateGrowCosts()
+	t = t.UpdateShadows()
+	return t
+}
+
+// Transform returns the image of the move by the symmetry
+func (m Move) Transform(sym Symmetry) Move {
+	switch m.code {
+	case SEED:
+		m.treeIndex, m.targetIndex = sym.cells[m.treeIndex], sym.cells[m.targetIndex]
+	case GROW, COMPLETE:
+		m.treeIndex = sym.cells[m.treeIndex]
+	}
+	return m
+}
+
+// GetCanonical returns the canonical form of the state and the symmetry that maps s to it
+func (s State) GetCanonical() (State, Symmetry) {
+	symmetries := s.GetSymmetries()
+	best, bestSym := s, symmetries[0]
+	bestKey := FormatState(s, false)
+	for _, sym := range symmetries[1:] {
+		t := s.Transform(sym)
+		if key := FormatState(t, false); key < bestKey {
+			best, bestSym, bestKey = t, sym, key
+		}
+	}
+	return best, bestSym
+}
+
+// GetCanonicalKey returns the notation of the canonical form of the state,
+// symmetric states of the same map have the same key
+func (s State) GetCanonicalKey() string {
+	canonical, _ := s.GetCanonical()
+	return FormatState(canonical, false)
+}
@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestSunPointsSymmetryInvariance(t *testing.T) {
+	nbSymmetries := 0
+	for seed := int64(1); seed <= 5; seed++ {
+		states := playRandomGame(seed)
+		nbSymmetries += len(mapSymmetries) - 1
+		for _, s := range states {
+			for _, sym := range mapSymmetries {
+				// the image is lit from the image of the sun direction
+				image := s.Transform(sym)
+				image.day = s.day - s.day%6 + sym.directions[s.day%6]
+				image = image.UpdateShadows()
+				if image.GetSunPoints() != s.GetSunPoints() {
+					t.Fatalf("seed %d: %q gets %v sun points, its image %q gets %v",
+						seed, FormatState(s, false), s.GetSunPoints(), FormatState(image, false), image.GetSunPoints())
+				}
+			}
+			for _, sym := range s.GetSymmetries() {
+				if key := s.Transform(sym).GetCanonicalKey(); key != s.GetCanonicalKey() {
+					t.Fatalf("seed %d: %q and its image have the canonical keys %q and %q",
+						seed, FormatState(s, false), s.GetCanonicalKey(), key)
+				}
+			}
+		}
+	}
+	if nbSymmetries == 0 {
+		t.Fatal("no map with a symmetry but the identity")
+	}
+}
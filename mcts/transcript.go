@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/************************************************/
+/*												*/
+/*				INPUT TRANSCRIPTS				*/
+/*												*/
+/************************************************/
+
+// A transcript is every line the bot read on stdin, in order, starting with the init block.
+// Copied to stderr, every line is prefixed with TRANSCRIPT_PREFIX so that it can be picked out of
+// the CodinGame console: a transcript file may hold other lines, they are ignored as soon as one
+// line has the prefix.
+// The bot adds notes to its transcript, lines prefixed with TRANSCRIPT_NOTE_PREFIX: first the
+// strategy, its seed and its number of iterations, then the move of every turn, followed by "cut"
+// if the deadline ended its search:
+//
+//	OUT bot mcts seed 3 iterations 300
+//	OUT move GROW 21
+//	OUT move WAIT cut
+
+const (
+	TRANSCRIPT_PREFIX      = "IN "
+	TRANSCRIPT_NOTE_PREFIX = "OUT "
+
+	// CodinGame runs the bot without flags: the bot copies its input to stderr unless it is run with
+	// -transcript-stderr=false, so that the games of the arena can be replayed from their console
+	DEFAULT_TRANSCRIPT_STDERR = true
+)
+
+// prefixWriter writes everything to w with prefix at the start of every line
+type prefixWriter struct {
+	w           io.Writer
+	prefix      string
+	atLineStart bool
+}
+
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	buf := bytes.Buffer{}
+	for _, c := range p {
+		if pw.atLineStart {
+			buf.WriteString(pw.prefix)
+		}
+		buf.WriteByte(c)
+		pw.atLineStart = c == '\n'
+	}
+	if _, err := pw.w.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// openInput returns stdin, copied to the transcript file and to stderr if asked, the writer of the
+// notes of the transcripts and a function closing the transcript file
+func openInput(transcriptPath string, toStderr bool) (io.Reader, io.Writer, func()) {
+	var input io.Reader = os.Stdin
+	notes := []io.Writer{}
+	closeInput := func() {}
+	if transcriptPath != "" {
+		f, err := os.Create(transcriptPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		} else {
+			input = io.TeeReader(input, f)
+			notes = append(notes, &prefixWriter{w: f, prefix: TRANSCRIPT_NOTE_PREFIX, atLineStart: true})
+			closeInput = func() { f.Close() }
+		}
+	}
+	if toStderr {
+		input = io.TeeReader(input, &prefixWriter{w: os.Stderr, prefix: TRANSCRIPT_PREFIX, atLineStart: true})
+		notes = append(notes, &prefixWriter{w: os.Stderr, prefix: TRANSCRIPT_NOTE_PREFIX, atLineStart: true})
+	}
+	return input, io.MultiWriter(notes...), closeInput
+}
+
+// ReadTranscript returns the input lines and the notes of a transcript, without their prefix
+func ReadTranscript(r io.Reader) ([]string, []string, error) {
+	lines, notes := []string{}, []string{}
+	isPrefixed := false
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1000000), 1000000)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, TRANSCRIPT_NOTE_PREFIX) {
+			notes = append(notes, strings.TrimPrefix(line, TRANSCRIPT_NOTE_PREFIX))
+			continue
+		}
+		if strings.HasPrefix(line, TRANSCRIPT_PREFIX) && !isPrefixed {
+			isPrefixed = true
+			lines = lines[:0]
+		}
+		if isPrefixed {
+			if !strings.HasPrefix(line, TRANSCRIPT_PREFIX) {
+				continue
+			}
+			line = strings.TrimPrefix(line, TRANSCRIPT_PREFIX)
+		}
+		lines = append(lines, line)
+	}
+	return lines, notes, scanner.Err()
+}
+
+// TranscriptMove is a move the bot noted in its transcript
+type TranscriptMove struct {
+	move  string
+	isCut bool
+}
+
+// ParseTranscriptNotes returns the strategy, the seed, the number of iterations and the moves noted
+// in a transcript, ok is false if it has no notes
+func ParseTranscriptNotes(notes []string) (strategyName string, seed int64, iterations int, moves []TranscriptMove, ok bool) {
+	for _, note := range notes {
+		if n, _ := fmt.Sscanf(note, "bot %s seed %d iterations %d", &strategyName, &seed, &iterations); n == 3 {
+			ok = true
+		} else if move, found := strings.CutPrefix(note, "move "); found {
+			cutMove, isCut := strings.CutSuffix(move, " cut")
+			moves = append(moves, TranscriptMove{move: cutMove, isCut: isCut})
+		}
+	}
+	return strategyName, seed, iterations, moves, ok
+}
+
+// runReplayInput feeds a transcript to a strategy again, with a fixed seed and a fixed number of
+// iterations per turn so that every decision is the same from one run to the next: by default those
+// the transcript notes. The moves are compared with those the bot played, the moves of the turns
+// whose search the deadline cut are reported apart, they depend on the speed of the machine.
+func runReplayInput(args []string) {
+	flags := flag.NewFlagSet("replay-input", flag.ExitOnError)
+	strategyName := flags.String("strategy", "mcts", "strategy the transcript is fed to, the noted one by default: "+strings.Join(GetStrategyNames(), ", "))
+	seed := flags.Int64("seed", 1, "seed of the search, the noted one by default")
+	iterations := flags.Int("iterations", 1000, "number of search iterations per turn, the noted one by default")
+	stopTurn := flags.Int("turn", -1, "stop at this turn and print the search statistics of the MCTS bots")
+	showBoard := flags.Bool("board", false, "draw the board every turn")
+	color := flags.Bool("color", false, "use ANSI colors when drawing the board")
+	gameFlags := addGameFlags(flags)
+	flags.Parse(args)
+
+	loadGameFlags(gameFlags)
+
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: replay-input [flags] <transcript>")
+		os.Exit(2)
+	}
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	lines, notes, err := ReadTranscript(f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	isSet := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) { isSet[f.Name] = true })
+	notedStrategy, notedSeed, notedIterations, playedMoves, isNoted := ParseTranscriptNotes(notes)
+	if isNoted {
+		if !isSet["strategy"] {
+			*strategyName = notedStrategy
+		}
+		if !isSet["seed"] {
+			*seed = notedSeed
+		}
+		if notedIterations == 0 {
+			fmt.Println("the game was searched for a time, its moves are not expected to be played again")
+		} else if !isSet["iterations"] {
+			*iterations = notedIterations
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+	scanner.Buffer(make([]byte, 1000000), 1000000)
+	if err := readMap(scanner); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	strategy, err := NewStrategy(*strategyName, *seed)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	nbTurns, nbDifferent, nbCut, nbDifferentAfterCut := 0, 0, 0, 0
+	for turn := 0; ; turn++ {
+		state, ok := getData(scanner)
+		if !ok {
+			break
+		}
+		move := strategy.Choose(state, Budget{iterations: *iterations})
+		comparison := ""
+		if turn < len(playedMoves) {
+			nbTurns++
+			played := playedMoves[turn]
+			switch {
+			case played.isCut:
+				nbCut++
+				comparison = fmt.Sprintf(", played %s after the deadline cut its search", played.move)
+			case played.move != move.String() && nbCut > 0:
+				// the search of a cut turn leaves another tree and another random state to the next ones
+				nbDifferentAfterCut++
+				comparison = fmt.Sprintf(", played %s after a turn the deadline cut", played.move)
+			case played.move != move.String():
+				nbDifferent++
+				comparison = fmt.Sprintf(", DIFFERENT: played %s", played.move)
+			}
+
+			// the strategy follows the game that was played
+			playedMove, err := ParseMove(played.move)
+			if interruptible, ok := strategy.(Interruptible); ok && err == nil && playedMove != move {
+				interruptible.Played(state, playedMove)
+			}
+		}
+		fmt.Printf("turn %d day %d: %s%s\n", turn, state.day, move, comparison)
+		if *showBoard {
+			RenderBoard(os.Stdout, state, *color)
+		}
+		if turn == *stopTurn {
+			fmt.Println(FormatState(state, true))
+			bot, isBot := strategy.(*Bot)
+			if !isBot {
+				break
+			}
+			for _, st := range bot.gameTree.GetStats(PLAYER) {
+				fmt.Printf("  %-12s visits %7d  value %.3f\n", st.move, st.visits, st.value)
+			}
+			fmt.Println("opponent actions:")
+			for _, a := range bot.opponent.GetHistory() {
+				fmt.Println("  " + a.String())
+			}
+			break
+		}
+	}
+	if nbTurns > 0 {
+		fmt.Printf("%d of %d moves different, %d turns cut by the deadline, %d other moves different after them\n",
+			nbDifferent, nbTurns, nbCut, nbDifferentAfterCut)
+	}
+}
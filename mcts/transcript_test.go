@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadTranscriptNotes(t *testing.T) {
+	console := strings.Join([]string{
+		"some debug line",
+		"IN 37",
+		"OUT bot mcts seed 3 iterations 300",
+		"IN 0 3 1 2 3 4 5 6",
+		"OUT move GROW 21",
+		"42ms",
+		"OUT move WAIT cut",
+	}, "\n")
+	lines, notes, err := ReadTranscript(strings.NewReader(console))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(lines, "|") != "37|0 3 1 2 3 4 5 6" {
+		t.Errorf("input lines %q", lines)
+	}
+	name, seed, iterations, moves, ok := ParseTranscriptNotes(notes)
+	if !ok || name != "mcts" || seed != 3 || iterations != 300 {
+		t.Errorf("noted %q seed %d iterations %d, ok %v", name, seed, iterations, ok)
+	}
+	expected := []TranscriptMove{{move: "GROW 21"}, {move: "WAIT", isCut: true}}
+	if len(moves) != len(expected) || moves[0] != expected[0] || moves[1] != expected[1] {
+		t.Errorf("noted moves %v", moves)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+/************************************************/
+/*												*/
+/*					WATCHDOG					*/
+/*												*/
+/************************************************/
+
+const (
+	// hard limits for printing the action, measured from the moment the input is read,
+	// a bit under the 1000ms and 100ms the game allows
+	FIRST_TURN_DEADLINE = 950 * time.Millisecond
+	TURN_DEADLINE       = 85 * time.Millisecond
+
+	// the search ends by itself this long before the deadline, the watchdog only steps in when it
+	// cannot (a long expansion, a GC pause...) and a busy goroutine can delay it by ~10ms
+	SEARCH_MARGIN = 15 * time.Millisecond
+)
+
+// Watchdog plays the turns of one strategy, late is the search of a strategy that is not
+// Interruptible still running after the deadline of its turn. isCut is true when the deadline ended
+// the search of the last turn before its number of iterations: its move depends on the speed of the
+// machine and cannot be replayed.
+type Watchdog struct {
+	late  chan choice
+	isCut bool
+}
+
+// choice is the result of SafeChoose
+type choice struct {
+	move Move
+	ok   bool
+}
+
+// PlayTurn lets the strategy choose its move within the budget and prints it with print, always
+// before the deadline: if an Interruptible strategy is still searching then, the best move it found
+// so far is printed and it is stopped, other strategies get the fallback move printed and their
+// move is dropped when it comes. It returns the printed move. A strategy is never used by two
+// turns at once: a turn waits until the late search of the previous one is over, and plays the
+// fallback move if it is still running at the deadline.
+func (w *Watchdog) PlayTurn(strategy Strategy, state State, budget Budget, deadline time.Time, print func(Move)) Move {
+	fallback := FallbackMove(state)
+	w.isCut = false
+	if w.late != nil {
+		timer := time.NewTimer(time.Until(deadline))
+		select {
+		case <-w.late:
+			timer.Stop()
+			w.late = nil
+		case <-timer.C:
+			print(fallback)
+			w.setCut(budget)
+			return fallback
+		}
+	}
+
+	interruptible, isInterruptible := strategy.(Interruptible)
+	if isInterruptible {
+		interruptible.Restart(fallback)
+	}
+	budget.deadline = deadline.Add(-SEARCH_MARGIN)
+
+	result := make(chan choice, 1)
+	go func() {
+		move, ok := SafeChoose(strategy, state, budget)
+		result <- choice{move: move, ok: ok}
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case c := <-result:
+		print(c.move)
+		if time.Now().After(budget.deadline) {
+			w.setCut(budget)
+		}
+		if !c.ok && isInterruptible {
+			interruptible.Played(state, c.move)
+		}
+		return c.move
+	case <-timer.C:
+	}
+
+	if !isInterruptible {
+		print(fallback)
+		w.late = result
+		w.setCut(budget)
+		return fallback
+	}
+	move := interruptible.GetBestMove()
+	print(move)
+	interruptible.Stop()
+	c := <-result
+	w.setCut(budget)
+
+	// the strategy must follow the move that was really played, it is told once
+	if !c.ok || c.move != move {
+		interruptible.Played(state, move)
+	}
+	return move
+}
+
+// setCut sets isCut and says it on stderr if the search of the turn was one of a number of iterations
+func (w *Watchdog) setCut(budget Budget) {
+	if budget.iterations > 0 {
+		w.isCut = true
+		fmt.Fprintf(os.Stderr, "the deadline cut the search of %d iterations, the move cannot be replayed\n", budget.iterations)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// slowStrategy waits before it chooses to wait
+type slowStrategy struct {
+	delay time.Duration
+}
+
+func (ss slowStrategy) Name() string {
+	return "slow"
+}
+
+func (ss slowStrategy) Choose(s State, budget Budget) Move {
+	time.Sleep(ss.delay)
+	return Move{code: WAIT}
+}
+
+// panickyStrategy panics in the middle of its search and counts the calls to Played
+type panickyStrategy struct {
+	nbPlayed int
+}
+
+func (ps *panickyStrategy) Name() string {
+	return "panicky"
+}
+
+func (ps *panickyStrategy) Choose(s State, budget Budget) Move {
+	time.Sleep(20 * time.Millisecond)
+	panic("search")
+}
+
+func (ps *panickyStrategy) Restart(fallback Move) {}
+
+func (ps *panickyStrategy) Stop() {}
+
+func (ps *panickyStrategy) GetBestMove() Move {
+	return Move{code: WAIT}
+}
+
+func (ps *panickyStrategy) Played(s State, m Move) {
+	ps.nbPlayed++
+}
+
+func TestPlayTurnIsNeverLate(t *testing.T) {
+	s := playRandomGame(1)[0]
+	w := &Watchdog{}
+	strategy := slowStrategy{delay: 100 * time.Millisecond}
+	for turn := 0; turn < 3; turn++ {
+		t0 := time.Now()
+		w.PlayTurn(strategy, s, Budget{duration: time.Second}, t0.Add(30*time.Millisecond), func(Move) {})
+		if elapsed := time.Since(t0); elapsed > 40*time.Millisecond {
+			t.Errorf("turn %d took %s for a deadline of 30ms", turn, elapsed)
+		}
+	}
+}
+
+func TestPlayTurnTellsPlayedOnce(t *testing.T) {
+	s := playRandomGame(1)[0]
+	strategy := &panickyStrategy{}
+	(&Watchdog{}).PlayTurn(strategy, s, Budget{duration: time.Second}, time.Now().Add(10*time.Millisecond), func(Move) {})
+	if strategy.nbPlayed != 1 {
+		t.Errorf("Played called %d times", strategy.nbPlayed)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+/************************************************/
+/*												*/
+/*				PROGRESSIVE WIDENING			*/
+/*												*/
+/************************************************/
+
+// A node lists its moves with the seeds last, the most promising seeds first. Every move but the
+// seeds is always considered by the selection, the seeds only WIDENING_MIN_SEEDS at first, then
+// more of them as the node gets visited: WIDENING_COEF * nbVisit^WIDENING_EXPONENT more.
+
+const (
+	WIDENING_MIN_SEEDS = 2
+	WIDENING_COEF      = 1.0
+	WIDENING_EXPONENT  = 0.5
+
+	// a seed in line with a tree within 3 cells gets shaded or shades it on some days,
+	// these are the losses of prior for each such tree
+	OWN_LINE_PENALTY      = 1.0
+	OPPONENT_LINE_PENALTY = 0.5
+)
+
+// IsInLine returns true if the two cells are on the same line of the board, within 3 cells
+func IsInLine(a int, b int) bool {
+	q, r := cellCoords[a][0]-cellCoords[b][0], cellCoords[a][1]-cellCoords[b][1]
+	return (q == 0 || r == 0 || q+r == 0) && GetDistance(a, b) <= 3
+}
+
+// GetSeedPrior returns how promising the seed of playerCode looks: the richness of its target,
+// less the trees it would shade or be shaded by, a smaller thrower first when those are equal
+func (s State) GetSeedPrior(m Move, playerCode int) float64 {
+	prior := float64(richnessMap[m.targetIndex])
+	for owner := 0; owner < 2; owner++ {
+		penalty := OPPONENT_LINE_PENALTY
+		if owner == playerCode {
+			penalty = OWN_LINE_PENALTY
+		}
+		for _, trees := range [][]int{s.activeTreesIndex[owner], s.dormantTreesIndex[owner]} {
+			for _, treeIndex := range trees {
+				if treeIndex != m.treeIndex && IsInLine(treeIndex, m.targetIndex) {
+					prior -= penalty
+				}
+			}
+		}
+	}
+	return prior - 0.01*float64(s.treeMap[m.treeIndex])
+}
+
+// SortSeeds moves the seeds at the end of moves, the most promising first, and returns
+// the index of the first seed
+func (s State) SortSeeds(moves []Move, playerCode int) int {
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].code != SEED && moves[j].code == SEED
+	})
+	firstSeed := len(moves)
+	for i, m := range moves {
+		if m.code == SEED {
+			firstSeed = i
+			break
+		}
+	}
+	seeds := moves[firstSeed:]
+	priors := make(map[Move]float64, len(seeds))
+	for _, m := range seeds {
+		priors[m] = s.GetSeedPrior(m, playerCode)
+	}
+	sort.SliceStable(seeds, func(i, j int) bool { return priors[seeds[i]] > priors[seeds[j]] })
+	return firstSeed
+}
+
+// GetWidth returns how many moves of playerCode the selection considers, the first ones of the list
+func (n *Node) GetWidth(playerCode int) int {
+	nbMoves := len(n.playerMoveList)
+	if playerCode == OPPONENT {
+		nbMoves = len(n.opponentMoveList)
+	}
+	nbSeeds := WIDENING_MIN_SEEDS + int(WIDENING_COEF*math.Pow(float64(n.nbVisit), WIDENING_EXPONENT))
+	return min(n.firstSeed[playerCode]+nbSeeds, nbMoves)
+}
@@ -0,0 +1,172 @@
+package main
+
+/************************************************/
+/*												*/
+/*				SCRIPTED OPPONENTS				*/
+/*												*/
+/************************************************/
+
+// The scripted strategies are simple opponents with a marked style, to test the bots against.
+// They are registered like the other strategies, so each one runs as a standalone bot with
+// -strategy <name> and in process in the referee.
+
+const (
+	// scripts that complete late start completing this many days before the end
+	LATE_COMPLETE_DAYS = 3
+)
+
+// ScriptedStrategy chooses its move with a fixed rule, without searching
+type ScriptedStrategy struct {
+	name   string
+	choose func(s State) Move
+}
+
+func (ss ScriptedStrategy) Name() string {
+	return ss.name
+}
+
+func (ss ScriptedStrategy) Choose(s State, budget Budget) Move {
+	return ss.choose(s)
+}
+
+func init() {
+	scripts := map[string]func(s State) Move{
+		"seeder":    ChooseSeeder,
+		"hoarder":   ChooseHoarder,
+		"harvester": ChooseHarvester,
+		"shadow":    ChooseShadowAggressor,
+	}
+	for name, choose := range scripts {
+		script := ScriptedStrategy{name: name, choose: choose}
+		RegisterStrategy(name, func(seed int64) Strategy { return script })
+	}
+}
+
+// getMovesByCode returns the legal moves of the player sorted by action code
+func getMovesByCode(s State) [4][]Move {
+	moves := [4][]Move{}
+	for _, m := range s.GetLegalMoves(PLAYER) {
+		moves[m.code] = append(moves[m.code], m)
+	}
+	return moves
+}
+
+// getBestSeed returns the seed on the richest cell, the closest to the center first
+func getBestSeed(seeds []Move) Move {
+	best := seeds[0]
+	for _, m := range seeds[1:] {
+		richness, bestRichness := richnessMap[m.targetIndex], richnessMap[best.targetIndex]
+		if richness > bestRichness || (richness == bestRichness && GetRing(m.targetIndex) < GetRing(best.targetIndex)) {
+			best = m
+		}
+	}
+	return best
+}
+
+// getBiggestGrow returns the grow of the biggest tree, the richest first
+func getBiggestGrow(s State, grows []Move) Move {
+	best := grows[0]
+	for _, m := range grows[1:] {
+		size, bestSize := s.treeMap[m.treeIndex], s.treeMap[best.treeIndex]
+		if size > bestSize || (size == bestSize && richnessMap[m.treeIndex] > richnessMap[best.treeIndex]) {
+			best = m
+		}
+	}
+	return best
+}
+
+// getRichestComplete returns the complete of the tree on the richest cell
+func getRichestComplete(completes []Move) Move {
+	best := completes[0]
+	for _, m := range completes[1:] {
+		if richnessMap[m.treeIndex] > richnessMap[best.treeIndex] {
+			best = m
+		}
+	}
+	return best
+}
+
+func isLastDays(s State) bool {
+	return s.day >= rules.NbDays-LATE_COMPLETE_DAYS
+}
+
+// ChooseSeeder seeds whenever it can, grows otherwise and completes in the last days
+func ChooseSeeder(s State) Move {
+	moves := getMovesByCode(s)
+	switch {
+	case isLastDays(s) && len(moves[COMPLETE]) > 0:
+		return getRichestComplete(moves[COMPLETE])
+	case len(moves[SEED]) > 0 && !isLastDays(s):
+		return getBestSeed(moves[SEED])
+	case len(moves[GROW]) > 0:
+		return getBiggestGrow(s, moves[GROW])
+	}
+	return Move{code: WAIT}
+}
+
+// ChooseHoarder grows its trees and keeps them for their sun, it only completes in the last days
+// and only seeds when it is free
+func ChooseHoarder(s State) Move {
+	moves := getMovesByCode(s)
+	switch {
+	case isLastDays(s) && len(moves[COMPLETE]) > 0:
+		return getRichestComplete(moves[COMPLETE])
+	case len(moves[GROW]) > 0:
+		return getBiggestGrow(s, moves[GROW])
+	case len(moves[SEED]) > 0 && s.nbTrees[PLAYER][0] == 0 && !isLastDays(s):
+		return getBestSeed(moves[SEED])
+	}
+	return Move{code: WAIT}
+}
+
+// ChooseHarvester completes every tree as soon as it can, grows otherwise and seeds when it is free
+func ChooseHarvester(s State) Move {
+	moves := getMovesByCode(s)
+	switch {
+	case len(moves[COMPLETE]) > 0:
+		return getRichestComplete(moves[COMPLETE])
+	case len(moves[GROW]) > 0:
+		return getBiggestGrow(s, moves[GROW])
+	case len(moves[SEED]) > 0 && s.nbTrees[PLAYER][0] == 0:
+		return getBestSeed(moves[SEED])
+	}
+	return Move{code: WAIT}
+}
+
+// GetNextDayIncome returns the sun both players would collect at the start of the next day
+// if nothing else was played
+func (s State) GetNextDayIncome() [2]int {
+	s = s.CloneTrees()
+	for i := 0; i < 2; i++ {
+		s.activeTreesIndex[i] = append(s.activeTreesIndex[i], s.dormantTreesIndex[i]...)
+		s.dormantTreesIndex[i] = nil
+	}
+	s.day++
+	s = s.UpdateShadows()
+	return s.GetSunIncome()
+}
+
+// ChooseShadowAggressor plays the grow or seed that takes the most sun from the opponent on the next
+// day, for the least sun lost by itself, it completes in the last days and waits if nothing hurts
+func ChooseShadowAggressor(s State) Move {
+	moves := getMovesByCode(s)
+	if isLastDays(s) && len(moves[COMPLETE]) > 0 {
+		return getRichestComplete(moves[COMPLETE])
+	}
+
+	income := s.GetNextDayIncome()
+	bestMove := Move{code: WAIT}
+	bestValue := 0
+	for _, m := range append(moves[GROW], moves[SEED]...) {
+		nextIncome := s.Play(m, Move{code: WAIT}).GetNextDayIncome()
+		value := (income[OPPONENT] - nextIncome[OPPONENT]) - (income[PLAYER] - nextIncome[PLAYER])
+		if value > bestValue {
+			bestMove = m
+			bestValue = value
+		}
+	}
+	if bestMove.code == WAIT && len(moves[GROW]) > 0 {
+		return getBiggestGrow(s, moves[GROW])
+	}
+	return bestMove
+}